@@ -1,5 +1,7 @@
 package base
 
+import "iter"
+
 // Collection represents a generic collection of elements.
 type Collection[T any] interface {
 	// Contains returns true if the collection contains the specified element.
@@ -19,4 +21,28 @@ type Collection[T any] interface {
 
 	// String returns string representation of the collection.
 	String() string
+
+	// Iterator returns an iterator over the elements of the collection, in the
+	// same order as Values.
+	Iterator() Iterator[T]
+
+	// All returns an iterator over the elements of the collection, in the
+	// same order as Values, that can be used with range. Unlike Values, it
+	// does not copy the collection.
+	All() iter.Seq[T]
+}
+
+// Iterator iterates over the elements of a collection.
+type Iterator[T any] interface {
+	// HasNext returns true if the iteration has more elements.
+	HasNext() bool
+
+	// Next returns the next element in the iteration. Returns nil and false if
+	// the iteration has no more elements.
+	Next() (*T, bool)
+
+	// Remove removes the element last returned by Next from the underlying
+	// collection. Returns false if Next has not been called yet, or if the
+	// element has already been removed.
+	Remove() bool
 }
@@ -0,0 +1,15 @@
+package base
+
+import "reflect"
+
+// DeepEqual reports whether a and b are deeply equal using reflect.DeepEqual.
+// It is the equality used by collections that are not given one explicitly.
+func DeepEqual[T any](a, b T) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+// Equal reports whether a and b are equal using the == operator. It is the
+// equality used by collections of comparable elements.
+func Equal[T comparable](a, b T) bool {
+	return a == b
+}
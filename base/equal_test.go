@@ -0,0 +1,21 @@
+package base
+
+import "testing"
+
+func TestDeepEqual(t *testing.T) {
+	if !DeepEqual([]int{1, 2}, []int{1, 2}) {
+		t.Fatalf("Expected equal slices to be deeply equal")
+	}
+	if DeepEqual([]int{1, 2}, []int{2, 1}) {
+		t.Fatalf("Expected different slices to not be deeply equal")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	if !Equal("a", "a") {
+		t.Fatalf("Expected equal strings to be equal")
+	}
+	if Equal(1, 2) {
+		t.Fatalf("Expected different integers to not be equal")
+	}
+}
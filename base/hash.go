@@ -0,0 +1,21 @@
+package base
+
+import (
+	"hash/maphash"
+
+	"github.com/elias8/go-gather/internal/hashing"
+)
+
+// DeepHash returns a hash of v, computed with hash/maphash and the given seed,
+// such that values reported equal by DeepEqual hash identically. It is the
+// hash used by collections that are not given one explicitly.
+func DeepHash[T any](seed maphash.Seed, v T) uint64 {
+	return hashing.Deep(seed, v)
+}
+
+// Hash returns a hash of v using maphash.Comparable, such that values reported
+// equal by Equal hash identically. It is the hash used by collections of
+// comparable elements.
+func Hash[T comparable](seed maphash.Seed, v T) uint64 {
+	return maphash.Comparable(seed, v)
+}
@@ -0,0 +1,28 @@
+package base
+
+import (
+	"hash/maphash"
+	"math"
+	"testing"
+)
+
+func TestDeepHash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	a, b := 1, 1
+	if DeepHash(seed, []*int{&a}) != DeepHash(seed, []*int{&b}) {
+		t.Fatalf("Expected deeply equal values to hash identically")
+	}
+	if DeepHash(seed, []int{1, 2}) == DeepHash(seed, []int{2, 1}) {
+		t.Fatalf("Expected different slices to hash differently")
+	}
+}
+
+func TestHash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	if Hash(seed, 0.0) != Hash(seed, math.Copysign(0, -1)) {
+		t.Fatalf("Expected equal floats to hash identically")
+	}
+	if Hash(seed, "a") == Hash(seed, "b") {
+		t.Fatalf("Expected different strings to hash differently")
+	}
+}
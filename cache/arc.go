@@ -0,0 +1,240 @@
+package cache
+
+import (
+	"iter"
+
+	"github.com/elias8/go-gather/list"
+)
+
+// arc implements the adaptive replacement cache of Megiddo and Modha. The
+// resident entries are split between recent, which holds entries used once
+// since they were cached, and frequent, which holds entries used at least
+// twice. The ghost lists recentGhosts and frequentGhosts remember the keys,
+// but not the values, of entries recently evicted from either list. A Put of
+// a ghost key means the list it was evicted from was too small, so the target
+// size of recent, target, moves towards that list.
+type arc[K comparable, V any] struct {
+	entries        map[K]*element[K, V]
+	recent         list.LinkedList[entry[K, V]]
+	frequent       list.LinkedList[entry[K, V]]
+	recentGhosts   list.LinkedList[entry[K, V]]
+	frequentGhosts list.LinkedList[entry[K, V]]
+	target         int
+	capacity       int
+	onEvict        func(key K, value V)
+	stats          Stats
+}
+
+// NewARC returns an empty Cache that holds at most capacity entries and
+// balances between evicting the least recently and the least frequently used
+// entries, adapting to the access pattern. It resists scans, which flush an
+// LRU cache, while keeping track of changes in the working set, which an LFU
+// cache is slow to follow. It also remembers up to capacity keys of evicted
+// entries. It panics if capacity is not positive.
+func NewARC[K comparable, V any](capacity int) Cache[K, V] {
+	checkCapacity(capacity)
+	return &arc[K, V]{
+		entries:        make(map[K]*element[K, V]),
+		recent:         newEntryList[K, V](),
+		frequent:       newEntryList[K, V](),
+		recentGhosts:   newEntryList[K, V](),
+		frequentGhosts: newEntryList[K, V](),
+		capacity:       capacity,
+	}
+}
+
+// resident returns the handle of the entry cached for key, ignoring ghosts.
+func (c *arc[K, V]) resident(key K) (*element[K, V], bool) {
+	e, ok := c.entries[key]
+	if !ok || e.Value.owner == c.recentGhosts || e.Value.owner == c.frequentGhosts {
+		return nil, false
+	}
+	return e, true
+}
+
+// push adds the entry to the front of the list l and records its handle.
+// Returns the handle.
+func (c *arc[K, V]) push(e entry[K, V], l list.LinkedList[entry[K, V]]) *element[K, V] {
+	e.owner = l
+	handle := l.PushFront(e)
+	c.entries[e.key] = handle
+	return handle
+}
+
+// move moves the entry of e to the front of the list l. Returns its new
+// handle.
+func (c *arc[K, V]) move(e *element[K, V], l list.LinkedList[entry[K, V]]) *element[K, V] {
+	e.Value.owner.RemoveElement(e)
+	return c.push(e.Value, l)
+}
+
+func (c *arc[K, V]) Get(key K) (*V, bool) {
+	e, ok := c.resident(key)
+	c.stats.record(ok)
+	if !ok {
+		return nil, false
+	}
+	e = c.move(e, c.frequent)
+	return &e.Value.value, true
+}
+
+func (c *arc[K, V]) Peek(key K) (*V, bool) {
+	e, ok := c.resident(key)
+	if !ok {
+		return nil, false
+	}
+	return &e.Value.value, true
+}
+
+func (c *arc[K, V]) Contains(key K) bool {
+	_, ok := c.resident(key)
+	return ok
+}
+
+func (c *arc[K, V]) Put(key K, value V) (*V, bool) {
+	e, ok := c.entries[key]
+	switch {
+	case ok && (e.Value.owner == c.recent || e.Value.owner == c.frequent):
+		previous := e.Value.value
+		e.Value.value = value
+		c.move(e, c.frequent)
+		return &previous, true
+	case ok && e.Value.owner == c.recentGhosts:
+		c.target = min(c.capacity, c.target+max(c.frequentGhosts.Size()/c.recentGhosts.Size(), 1))
+		c.recentGhosts.RemoveElement(e)
+		c.replace(false)
+	case ok:
+		c.target = max(0, c.target-max(c.recentGhosts.Size()/c.frequentGhosts.Size(), 1))
+		c.frequentGhosts.RemoveElement(e)
+		c.replace(true)
+	default:
+		c.makeRoom()
+		c.push(entry[K, V]{key: key, value: value}, c.recent)
+		return nil, false
+	}
+	e.Value.value = value
+	c.push(e.Value, c.frequent)
+	return nil, false
+}
+
+// makeRoom frees a resident slot for a key that is neither cached nor a
+// ghost, and trims the ghost lists so that they, together with the resident
+// entries, remember at most twice the capacity of the cache.
+func (c *arc[K, V]) makeRoom() {
+	if c.recent.Size()+c.recentGhosts.Size() == c.capacity {
+		if c.recent.Size() < c.capacity {
+			c.forget(c.recentGhosts)
+			c.replace(false)
+		} else {
+			c.evict(c.recent.Back())
+		}
+		return
+	}
+	total := c.recent.Size() + c.frequent.Size() + c.recentGhosts.Size() + c.frequentGhosts.Size()
+	if total >= c.capacity {
+		if total == 2*c.capacity {
+			c.forget(c.frequentGhosts)
+		}
+		c.replace(false)
+	}
+}
+
+// replace evicts an entry, if the cache is full, from recent if it holds more
+// entries than its target size, and from frequent otherwise. The key of the
+// evicted entry becomes a ghost. frequentGhost tells whether the entry being
+// added was a ghost of frequent, which tips the balance towards recent.
+func (c *arc[K, V]) replace(frequentGhost bool) {
+	if c.recent.Size()+c.frequent.Size() < c.capacity {
+		return
+	}
+	from, to := c.frequent, c.frequentGhosts
+	if c.recent.Size() > 0 && (c.recent.Size() > c.target || (frequentGhost && c.recent.Size() == c.target)) {
+		from, to = c.recent, c.recentGhosts
+	}
+	e := from.Back()
+	c.evict(e)
+	ghost := e.Value
+	var zero V
+	ghost.value = zero
+	c.push(ghost, to)
+}
+
+// forget drops the oldest ghost of l.
+func (c *arc[K, V]) forget(l list.LinkedList[entry[K, V]]) {
+	if e := l.Back(); e != nil {
+		delete(c.entries, e.Value.key)
+		l.RemoveElement(e)
+	}
+}
+
+func (c *arc[K, V]) evict(e *element[K, V]) {
+	delete(c.entries, e.Value.key)
+	e.Value.owner.RemoveElement(e)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(e.Value.key, e.Value.value)
+	}
+}
+
+func (c *arc[K, V]) Remove(key K) (*V, bool) {
+	e, ok := c.resident(key)
+	if !ok {
+		return nil, false
+	}
+	delete(c.entries, key)
+	e.Value.owner.RemoveElement(e)
+	return &e.Value.value, true
+}
+
+func (c *arc[K, V]) Len() int {
+	return c.recent.Size() + c.frequent.Size()
+}
+
+func (c *arc[K, V]) Cap() int {
+	return c.capacity
+}
+
+func (c *arc[K, V]) Clear() {
+	clear(c.entries)
+	c.recent.Clear()
+	c.frequent.Clear()
+	c.recentGhosts.Clear()
+	c.frequentGhosts.Clear()
+	c.target = 0
+}
+
+func (c *arc[K, V]) Keys() []K {
+	keys := make([]K, 0, c.Len())
+	for k := range c.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// All visits the frequently used entries, most recent first, followed by the
+// recently used ones. Which of the two lists loses an entry next depends on
+// the target size of recent, so the order is only an approximation of the
+// eviction order.
+func (c *arc[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, l := range []list.LinkedList[entry[K, V]]{c.frequent, c.recent} {
+			for e := l.Front(); e != nil; e = e.Next() {
+				if !yield(e.Value.key, e.Value.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (c *arc[K, V]) OnEvict(callback func(key K, value V)) {
+	c.onEvict = callback
+}
+
+func (c *arc[K, V]) Stats() Stats {
+	return c.stats
+}
+
+func (c *arc[K, V]) String() string {
+	return format[K, V]("ARC", c)
+}
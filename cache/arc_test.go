@@ -0,0 +1,66 @@
+package cache
+
+import "testing"
+
+func TestARC_ResistsScans(t *testing.T) {
+	c := NewARC[int, int](4)
+	for _, key := range []int{1, 2} {
+		c.Put(key, key)
+		c.Get(key)
+	}
+	for key := 100; key < 120; key++ {
+		c.Put(key, key)
+	}
+	if !c.Contains(1) || !c.Contains(2) {
+		t.Fatalf("Expected the frequently used keys to survive a scan, got %v", c)
+	}
+	if c.Len() != 4 {
+		t.Fatalf("Expected a full cache, got length %d", c.Len())
+	}
+}
+
+func TestARC_AdaptsToGhostHits(t *testing.T) {
+	c := NewARC[int, int](2).(*arc[int, int])
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Get(2)
+	c.Put(3, 3)
+	if c.Contains(1) {
+		t.Fatalf("Expected 1 to be evicted")
+	}
+	if c.recentGhosts.Size() != 1 {
+		t.Fatalf("Expected 1 to be remembered as a ghost, got %d ghosts", c.recentGhosts.Size())
+	}
+
+	if _, replaced := c.Put(1, 10); replaced {
+		t.Fatalf("Expected Put of a ghost key to not report a replaced value")
+	}
+	if c.target != 1 {
+		t.Fatalf("Expected a ghost hit to grow the target size of the recent list to 1, got %d", c.target)
+	}
+	if v, ok := c.Peek(1); !ok || *v != 10 {
+		t.Fatalf("Expected 1 to be cached again with value 10, got %v", v)
+	}
+	if c.Contains(2) || !c.Contains(3) {
+		t.Fatalf("Expected 2 to make room for 1 since the recent list is within its target, got %v", c)
+	}
+	if c.frequentGhosts.Size() != 1 {
+		t.Fatalf("Expected 2 to be remembered as a ghost, got %d ghosts", c.frequentGhosts.Size())
+	}
+}
+
+func TestARC_BoundsGhosts(t *testing.T) {
+	c := NewARC[int, int](3).(*arc[int, int])
+	for key := range 100 {
+		c.Put(key, key)
+		if key%3 == 0 {
+			c.Get(key)
+		}
+	}
+	if len(c.entries) > 2*c.capacity {
+		t.Fatalf("Expected at most %d remembered keys, got %d", 2*c.capacity, len(c.entries))
+	}
+	if c.Len() != 3 {
+		t.Fatalf("Expected a full cache, got length %d", c.Len())
+	}
+}
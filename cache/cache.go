@@ -0,0 +1,148 @@
+// Package cache provides fixed-capacity caches that evict entries when they
+// are full: least recently used (LRU), least frequently used (LFU), adaptive
+// replacement (ARC) and time-to-live (TTL) caches. Every operation takes O(1)
+// time. Caches are not safe for concurrent use; guard them with a mutex when
+// they are shared between goroutines.
+package cache
+
+import (
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/elias8/go-gather/list"
+)
+
+// Cache maps keys to values and holds at most a fixed number of entries. When
+// an entry is added to a full cache, another entry is evicted according to the
+// policy of the cache.
+type Cache[K comparable, V any] interface {
+	// Get returns the value cached for the key and records an access to it,
+	// which counts as a hit in the stats of the cache. Returns nil and false,
+	// and counts a miss, if the key is not cached.
+	Get(key K) (*V, bool)
+
+	// Peek returns the value cached for the key without recording an access
+	// to it. Returns nil and false if the key is not cached.
+	Peek(key K) (*V, bool)
+
+	// Contains returns true if the key is cached. It does not record an
+	// access to the key.
+	Contains(key K) bool
+
+	// Put caches the value for the key, evicting another entry if the cache
+	// is full. Returns the previous value and true if the key was already
+	// cached, nil and false otherwise.
+	Put(key K, value V) (*V, bool)
+
+	// Remove removes the key from the cache. Returns the removed value and
+	// true if the key was cached, nil and false otherwise. Removed entries are
+	// not passed to the eviction callback.
+	Remove(key K) (*V, bool)
+
+	// Len returns the number of entries in the cache.
+	Len() int
+
+	// Cap returns the maximum number of entries the cache holds.
+	Cap() int
+
+	// Clear removes all the entries from the cache without passing them to
+	// the eviction callback. The stats of the cache are kept.
+	Clear()
+
+	// Keys returns the keys of the cache, from the entry that would be evicted
+	// last to the one that would be evicted first.
+	Keys() []K
+
+	// All returns an iterator over the entries of the cache, in the same order
+	// as Keys. Iterating does not record accesses.
+	All() iter.Seq2[K, V]
+
+	// OnEvict sets the function called with every entry the cache evicts to
+	// make room for another or because it expired. The callback must not use
+	// the cache. Passing nil removes the callback.
+	OnEvict(callback func(key K, value V))
+
+	// Stats returns the number of hits, misses and evictions of the cache.
+	Stats() Stats
+
+	// String returns a string representation of the cache.
+	String() string
+}
+
+// Stats counts the outcomes of the lookups of a cache.
+type Stats struct {
+	// Hits is the number of calls to Get that found their key.
+	Hits int
+
+	// Misses is the number of calls to Get that did not find their key.
+	Misses int
+
+	// Evictions is the number of entries evicted to make room for others or
+	// because they expired.
+	Evictions int
+}
+
+// HitRatio returns the fraction of calls to Get that found their key, or zero
+// if Get was never called.
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// record counts the outcome of a lookup.
+func (s *Stats) record(hit bool) {
+	if hit {
+		s.Hits++
+	} else {
+		s.Misses++
+	}
+}
+
+// entry is a cached key-value pair, held as the value of an element of a
+// list.LinkedList whose front holds the entry that should be kept longest and
+// whose back the next one to evict. Which of the remaining fields are used
+// depends on the cache.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+
+	// owner is the list holding the entry, used by caches that move entries
+	// between several lists.
+	owner list.LinkedList[entry[K, V]]
+
+	// frequency is the number of accesses to the entry, used by LFU caches.
+	frequency int
+
+	// expires is the time at which the entry expires, used by TTL caches.
+	expires time.Time
+}
+
+// element is the handle of an entry in the list holding it.
+type element[K comparable, V any] = list.Element[entry[K, V]]
+
+// newEntryList returns an empty list of entries.
+func newEntryList[K comparable, V any]() list.LinkedList[entry[K, V]] {
+	return list.NewLinkedList[entry[K, V]]()
+}
+
+func checkCapacity(capacity int) {
+	if capacity <= 0 {
+		panic("cache: capacity must be positive")
+	}
+}
+
+func format[K comparable, V any](name string, c Cache[K, V]) string {
+	str := name + "({"
+	i := 0
+	for k, v := range c.All() {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v: %v", k, v)
+		i++
+	}
+	return str + "})"
+}
@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+var constructors = []struct {
+	name string
+	new  func(capacity int) Cache[string, int]
+}{
+	{name: "LRU", new: NewLRU[string, int]},
+	{name: "LFU", new: NewLFU[string, int]},
+	{name: "ARC", new: NewARC[string, int]},
+	{name: "TTL", new: func(capacity int) Cache[string, int] {
+		return NewTTLFunc[string, int](capacity, time.Minute, (&clock{}).now)
+	}},
+}
+
+// clock is a fake clock for TTL caches that only moves when told to.
+type clock struct {
+	current time.Time
+}
+
+func (c *clock) now() time.Time {
+	return c.current
+}
+
+func (c *clock) advance(d time.Duration) {
+	c.current = c.current.Add(d)
+}
+
+func sortedKeys(c Cache[string, int]) []string {
+	keys := c.Keys()
+	slices.Sort(keys)
+	return keys
+}
+
+func TestCache(t *testing.T) {
+	for _, ctor := range constructors {
+		t.Run(ctor.name, func(t *testing.T) {
+			c := ctor.new(2)
+			if c.Len() != 0 || c.Cap() != 2 {
+				t.Fatalf("Expected an empty cache of capacity 2, got length %d and capacity %d", c.Len(), c.Cap())
+			}
+
+			if _, replaced := c.Put("a", 1); replaced {
+				t.Fatalf("Expected Put of a new key to not replace a value")
+			}
+			if previous, replaced := c.Put("a", 2); !replaced || *previous != 1 {
+				t.Fatalf("Expected Put to replace 1, got %v", previous)
+			}
+			if v, ok := c.Get("a"); !ok || *v != 2 {
+				t.Fatalf("Expected Get(a) to return 2, got %v", v)
+			}
+			if _, ok := c.Get("b"); ok {
+				t.Fatalf("Expected Get(b) to miss")
+			}
+			if !c.Contains("a") || c.Contains("b") {
+				t.Fatalf("Expected the cache to contain only a")
+			}
+
+			c.Put("b", 3)
+			if got := sortedKeys(c); !slices.Equal(got, []string{"a", "b"}) {
+				t.Fatalf("Expected keys [a b], got %v", got)
+			}
+			if removed, ok := c.Remove("a"); !ok || *removed != 2 {
+				t.Fatalf("Expected Remove(a) to return 2, got %v", removed)
+			}
+			if _, ok := c.Remove("a"); ok {
+				t.Fatalf("Expected a second Remove(a) to fail")
+			}
+			if c.Len() != 1 {
+				t.Fatalf("Expected length 1, got %d", c.Len())
+			}
+
+			c.Clear()
+			if c.Len() != 0 || len(c.Keys()) != 0 {
+				t.Fatalf("Expected the cache to be empty after Clear, got %v", c)
+			}
+			stats := c.Stats()
+			if stats.Hits != 1 || stats.Misses != 1 || stats.Evictions != 0 {
+				t.Fatalf("Expected 1 hit, 1 miss and no evictions, got %+v", stats)
+			}
+			if stats.HitRatio() != 0.5 {
+				t.Fatalf("Expected a hit ratio of 0.5, got %v", stats.HitRatio())
+			}
+		})
+	}
+}
+
+func TestCache_Capacity(t *testing.T) {
+	for _, ctor := range constructors {
+		t.Run(ctor.name, func(t *testing.T) {
+			c := ctor.new(3)
+			var evicted []string
+			c.OnEvict(func(key string, value int) { evicted = append(evicted, key) })
+			for i, key := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+				c.Put(key, i)
+				c.Get(key)
+				if c.Len() > 3 {
+					t.Fatalf("Expected at most 3 entries, got %d", c.Len())
+				}
+			}
+			if len(evicted) != 4 || c.Stats().Evictions != 4 {
+				t.Fatalf("Expected 4 evictions, got %v and %d", evicted, c.Stats().Evictions)
+			}
+			for _, key := range evicted {
+				if c.Contains(key) {
+					t.Fatalf("Expected evicted key %s to not be cached", key)
+				}
+			}
+
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("Expected a non-positive capacity to panic")
+				}
+			}()
+			ctor.new(0)
+		})
+	}
+}
+
+func TestCache_Peek(t *testing.T) {
+	for _, ctor := range constructors {
+		t.Run(ctor.name, func(t *testing.T) {
+			c := ctor.new(2)
+			c.Put("a", 1)
+			if v, ok := c.Peek("a"); !ok || *v != 1 {
+				t.Fatalf("Expected Peek(a) to return 1, got %v", v)
+			}
+			if _, ok := c.Peek("b"); ok {
+				t.Fatalf("Expected Peek(b) to fail")
+			}
+			if stats := c.Stats(); stats.Hits != 0 || stats.Misses != 0 {
+				t.Fatalf("Expected Peek to not count in the stats, got %+v", stats)
+			}
+		})
+	}
+}
+
+func TestStats_HitRatio(t *testing.T) {
+	if ratio := (Stats{}).HitRatio(); ratio != 0 {
+		t.Fatalf("Expected a hit ratio of 0 without lookups, got %v", ratio)
+	}
+	if ratio := (Stats{Hits: 3, Misses: 1}).HitRatio(); ratio != 0.75 {
+		t.Fatalf("Expected a hit ratio of 0.75, got %v", ratio)
+	}
+}
@@ -0,0 +1,176 @@
+package cache
+
+import (
+	"iter"
+	"maps"
+	"slices"
+
+	"github.com/elias8/go-gather/list"
+)
+
+// lfu groups its entries into one list per access frequency, each ordered by
+// recency of access, most recent first. The entry to evict is at the back of
+// the list of the lowest frequency, which is tracked as entries move between
+// lists, so every operation takes O(1) time.
+type lfu[K comparable, V any] struct {
+	entries      map[K]*element[K, V]
+	frequencies  map[int]list.LinkedList[entry[K, V]]
+	minFrequency int
+	capacity     int
+	onEvict      func(key K, value V)
+	stats        Stats
+}
+
+// NewLFU returns an empty Cache that holds at most capacity entries and
+// evicts the least frequently used entry when it is full. Both Get and Put
+// count as a use, and ties are broken by evicting the least recently used of
+// the entries. It panics if capacity is not positive.
+func NewLFU[K comparable, V any](capacity int) Cache[K, V] {
+	checkCapacity(capacity)
+	return &lfu[K, V]{
+		entries:     make(map[K]*element[K, V]),
+		frequencies: make(map[int]list.LinkedList[entry[K, V]]),
+		capacity:    capacity,
+	}
+}
+
+func (c *lfu[K, V]) Get(key K) (*V, bool) {
+	e, ok := c.entries[key]
+	c.stats.record(ok)
+	if !ok {
+		return nil, false
+	}
+	e = c.touch(e)
+	return &e.Value.value, true
+}
+
+func (c *lfu[K, V]) Peek(key K) (*V, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	return &e.Value.value, true
+}
+
+func (c *lfu[K, V]) Contains(key K) bool {
+	_, ok := c.entries[key]
+	return ok
+}
+
+func (c *lfu[K, V]) Put(key K, value V) (*V, bool) {
+	if e, ok := c.entries[key]; ok {
+		previous := e.Value.value
+		e.Value.value = value
+		c.touch(e)
+		return &previous, true
+	}
+	if len(c.entries) == c.capacity {
+		c.evict(c.frequencies[c.minFrequency].Back())
+	}
+	c.link(entry[K, V]{key: key, value: value, frequency: 1})
+	c.minFrequency = 1
+	return nil, false
+}
+
+func (c *lfu[K, V]) Remove(key K) (*V, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	delete(c.entries, key)
+	c.unlink(e)
+	return &e.Value.value, true
+}
+
+// touch records an access to e by moving its entry to the list of the next
+// frequency. Returns the new handle of the entry.
+func (c *lfu[K, V]) touch(e *element[K, V]) *element[K, V] {
+	c.unlink(e)
+	if c.minFrequency == e.Value.frequency && c.frequencies[e.Value.frequency] == nil {
+		c.minFrequency++
+	}
+	touched := e.Value
+	touched.frequency++
+	return c.link(touched)
+}
+
+// link adds the entry to the front of the list of its frequency and records
+// its handle. Returns the handle.
+func (c *lfu[K, V]) link(e entry[K, V]) *element[K, V] {
+	l, ok := c.frequencies[e.frequency]
+	if !ok {
+		l = newEntryList[K, V]()
+		c.frequencies[e.frequency] = l
+	}
+	handle := l.PushFront(e)
+	c.entries[e.key] = handle
+	return handle
+}
+
+// unlink removes e from the list of its frequency, dropping the list if it
+// becomes empty. The minimum frequency is left for the caller to update.
+func (c *lfu[K, V]) unlink(e *element[K, V]) {
+	l := c.frequencies[e.Value.frequency]
+	l.RemoveElement(e)
+	if l.IsEmpty() {
+		delete(c.frequencies, e.Value.frequency)
+	}
+}
+
+func (c *lfu[K, V]) evict(e *element[K, V]) {
+	delete(c.entries, e.Value.key)
+	c.unlink(e)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(e.Value.key, e.Value.value)
+	}
+}
+
+func (c *lfu[K, V]) Len() int {
+	return len(c.entries)
+}
+
+func (c *lfu[K, V]) Cap() int {
+	return c.capacity
+}
+
+func (c *lfu[K, V]) Clear() {
+	clear(c.entries)
+	clear(c.frequencies)
+	c.minFrequency = 0
+}
+
+func (c *lfu[K, V]) Keys() []K {
+	keys := make([]K, 0, len(c.entries))
+	for k := range c.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// All visits the entries from the most to the least frequently used. Sorting
+// the frequencies makes it take O(n log n) time.
+func (c *lfu[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		frequencies := slices.Sorted(maps.Keys(c.frequencies))
+		for _, f := range slices.Backward(frequencies) {
+			for e := c.frequencies[f].Front(); e != nil; e = e.Next() {
+				if !yield(e.Value.key, e.Value.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (c *lfu[K, V]) OnEvict(callback func(key K, value V)) {
+	c.onEvict = callback
+}
+
+func (c *lfu[K, V]) Stats() Stats {
+	return c.stats
+}
+
+func (c *lfu[K, V]) String() string {
+	return format[K, V]("LFU", c)
+}
@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLFU_Eviction(t *testing.T) {
+	c := NewLFU[string, int](3)
+	var evicted []string
+	c.OnEvict(func(key string, value int) { evicted = append(evicted, key) })
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Put("d", 4)
+
+	if !slices.Equal(evicted, []string{"c"}) {
+		t.Fatalf("Expected the least frequently used key c to be evicted, got %v", evicted)
+	}
+
+	c.Put("e", 5)
+	if !slices.Equal(evicted, []string{"c", "d"}) {
+		t.Fatalf("Expected the new key d to be evicted next, got %v", evicted)
+	}
+	if got := c.Keys(); !slices.Equal(got, []string{"a", "b", "e"}) {
+		t.Fatalf("Expected keys from the most to the least frequently used [a b e], got %v", got)
+	}
+}
+
+func TestLFU_TiesEvictLeastRecentlyUsed(t *testing.T) {
+	c := NewLFU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("b")
+	c.Get("a")
+	c.Put("c", 3)
+	if c.Contains("b") || !c.Contains("a") {
+		t.Fatalf("Expected b, the least recently used of the most used keys, to be evicted, got %v", c)
+	}
+}
+
+func TestLFU_RemoveKeepsMinimumFrequency(t *testing.T) {
+	c := NewLFU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("b")
+	c.Remove("a")
+	c.Put("c", 3)
+	c.Put("d", 4)
+	if !c.Contains("b") || c.Contains("c") || !c.Contains("d") {
+		t.Fatalf("Expected c to be evicted, got %v", c)
+	}
+}
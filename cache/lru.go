@@ -0,0 +1,124 @@
+package cache
+
+import (
+	"iter"
+
+	"github.com/elias8/go-gather/list"
+)
+
+// lru keeps its entries in a list ordered by recency of access, most recent
+// first, so the entry to evict is always at the back.
+type lru[K comparable, V any] struct {
+	entries  map[K]*element[K, V]
+	order    list.LinkedList[entry[K, V]]
+	capacity int
+	onEvict  func(key K, value V)
+	stats    Stats
+}
+
+// NewLRU returns an empty Cache that holds at most capacity entries and
+// evicts the least recently used entry when it is full. Both Get and Put count
+// as a use. It panics if capacity is not positive.
+func NewLRU[K comparable, V any](capacity int) Cache[K, V] {
+	checkCapacity(capacity)
+	return &lru[K, V]{entries: make(map[K]*element[K, V]), order: newEntryList[K, V](), capacity: capacity}
+}
+
+func (c *lru[K, V]) Get(key K) (*V, bool) {
+	e, ok := c.entries[key]
+	c.stats.record(ok)
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return &e.Value.value, true
+}
+
+func (c *lru[K, V]) Peek(key K) (*V, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	return &e.Value.value, true
+}
+
+func (c *lru[K, V]) Contains(key K) bool {
+	_, ok := c.entries[key]
+	return ok
+}
+
+func (c *lru[K, V]) Put(key K, value V) (*V, bool) {
+	if e, ok := c.entries[key]; ok {
+		previous := e.Value.value
+		e.Value.value = value
+		c.order.MoveToFront(e)
+		return &previous, true
+	}
+	if len(c.entries) == c.capacity {
+		c.evict(c.order.Back())
+	}
+	c.entries[key] = c.order.PushFront(entry[K, V]{key: key, value: value})
+	return nil, false
+}
+
+func (c *lru[K, V]) Remove(key K) (*V, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	delete(c.entries, key)
+	c.order.RemoveElement(e)
+	return &e.Value.value, true
+}
+
+func (c *lru[K, V]) evict(e *element[K, V]) {
+	delete(c.entries, e.Value.key)
+	c.order.RemoveElement(e)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(e.Value.key, e.Value.value)
+	}
+}
+
+func (c *lru[K, V]) Len() int {
+	return len(c.entries)
+}
+
+func (c *lru[K, V]) Cap() int {
+	return c.capacity
+}
+
+func (c *lru[K, V]) Clear() {
+	clear(c.entries)
+	c.order.Clear()
+}
+
+func (c *lru[K, V]) Keys() []K {
+	keys := make([]K, 0, len(c.entries))
+	for k := range c.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (c *lru[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := c.order.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value.key, e.Value.value) {
+				return
+			}
+		}
+	}
+}
+
+func (c *lru[K, V]) OnEvict(callback func(key K, value V)) {
+	c.onEvict = callback
+}
+
+func (c *lru[K, V]) Stats() Stats {
+	return c.stats
+}
+
+func (c *lru[K, V]) String() string {
+	return format[K, V]("LRU", c)
+}
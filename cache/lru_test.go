@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLRU_Eviction(t *testing.T) {
+	c := NewLRU[string, int](3)
+	var evicted []string
+	c.OnEvict(func(key string, value int) { evicted = append(evicted, key) })
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Put("d", 4)
+	c.Put("b", 5)
+	c.Put("e", 6)
+
+	if !slices.Equal(evicted, []string{"b", "c", "a"}) {
+		t.Fatalf("Expected b, c and a to be evicted, got %v", evicted)
+	}
+	if got := c.Keys(); !slices.Equal(got, []string{"e", "b", "d"}) {
+		t.Fatalf("Expected keys from the most to the least recently used [e b d], got %v", got)
+	}
+}
+
+func TestLRU_PeekDoesNotRefresh(t *testing.T) {
+	c := NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Peek("a")
+	c.Put("c", 3)
+	if c.Contains("a") {
+		t.Fatalf("Expected Peek to not protect a from eviction")
+	}
+}
+
+func TestLRU_String(t *testing.T) {
+	c := NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	if got := c.String(); got != "LRU({b: 2, a: 1})" {
+		t.Fatalf("Expected LRU({b: 2, a: 1}), got %s", got)
+	}
+}
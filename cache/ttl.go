@@ -0,0 +1,165 @@
+package cache
+
+import (
+	"iter"
+	"time"
+
+	"github.com/elias8/go-gather/list"
+)
+
+// ttl keeps its entries in a list ordered by the time they were last put,
+// most recent first. Every entry lives for the same duration, so the list is
+// also ordered by expiry and expired entries are always at its back, where
+// every operation removes them before doing anything else.
+type ttl[K comparable, V any] struct {
+	entries  map[K]*element[K, V]
+	order    list.LinkedList[entry[K, V]]
+	ttl      time.Duration
+	now      func() time.Time
+	capacity int
+	onEvict  func(key K, value V)
+	stats    Stats
+}
+
+// NewTTL returns an empty Cache that holds at most capacity entries, each of
+// which expires ttl after it was last put. When the cache is full, the entry
+// closest to expiring is evicted. Expired entries are evicted lazily, the
+// next time the cache is used. It panics if capacity or ttl is not positive.
+func NewTTL[K comparable, V any](capacity int, ttl time.Duration) Cache[K, V] {
+	return NewTTLFunc[K, V](capacity, ttl, time.Now)
+}
+
+// NewTTLFunc returns an empty TTL Cache like NewTTL that reads the current
+// time from the given now function instead of the system clock, which lets
+// tests control expiry. If now is nil, time.Now is used.
+func NewTTLFunc[K comparable, V any](capacity int, duration time.Duration, now func() time.Time) Cache[K, V] {
+	checkCapacity(capacity)
+	if duration <= 0 {
+		panic("cache: ttl must be positive")
+	}
+	if now == nil {
+		now = time.Now
+	}
+	return &ttl[K, V]{
+		entries:  make(map[K]*element[K, V]),
+		order:    newEntryList[K, V](),
+		ttl:      duration,
+		now:      now,
+		capacity: capacity,
+	}
+}
+
+// expire evicts the entries that have expired.
+func (c *ttl[K, V]) expire() {
+	now := c.now()
+	for e := c.order.Back(); e != nil && !now.Before(e.Value.expires); e = c.order.Back() {
+		c.evict(e)
+	}
+}
+
+func (c *ttl[K, V]) Get(key K) (*V, bool) {
+	c.expire()
+	e, ok := c.entries[key]
+	c.stats.record(ok)
+	if !ok {
+		return nil, false
+	}
+	return &e.Value.value, true
+}
+
+func (c *ttl[K, V]) Peek(key K) (*V, bool) {
+	c.expire()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	return &e.Value.value, true
+}
+
+func (c *ttl[K, V]) Contains(key K) bool {
+	c.expire()
+	_, ok := c.entries[key]
+	return ok
+}
+
+func (c *ttl[K, V]) Put(key K, value V) (*V, bool) {
+	c.expire()
+	expires := c.now().Add(c.ttl)
+	if e, ok := c.entries[key]; ok {
+		previous := e.Value.value
+		e.Value.value = value
+		e.Value.expires = expires
+		c.order.MoveToFront(e)
+		return &previous, true
+	}
+	if len(c.entries) == c.capacity {
+		c.evict(c.order.Back())
+	}
+	c.entries[key] = c.order.PushFront(entry[K, V]{key: key, value: value, expires: expires})
+	return nil, false
+}
+
+func (c *ttl[K, V]) Remove(key K) (*V, bool) {
+	c.expire()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	delete(c.entries, key)
+	c.order.RemoveElement(e)
+	return &e.Value.value, true
+}
+
+func (c *ttl[K, V]) evict(e *element[K, V]) {
+	delete(c.entries, e.Value.key)
+	c.order.RemoveElement(e)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(e.Value.key, e.Value.value)
+	}
+}
+
+func (c *ttl[K, V]) Len() int {
+	c.expire()
+	return len(c.entries)
+}
+
+func (c *ttl[K, V]) Cap() int {
+	return c.capacity
+}
+
+func (c *ttl[K, V]) Clear() {
+	clear(c.entries)
+	c.order.Clear()
+}
+
+func (c *ttl[K, V]) Keys() []K {
+	keys := make([]K, 0, len(c.entries))
+	for k := range c.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (c *ttl[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		c.expire()
+		for e := c.order.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value.key, e.Value.value) {
+				return
+			}
+		}
+	}
+}
+
+func (c *ttl[K, V]) OnEvict(callback func(key K, value V)) {
+	c.onEvict = callback
+}
+
+func (c *ttl[K, V]) Stats() Stats {
+	return c.stats
+}
+
+func (c *ttl[K, V]) String() string {
+	return format[K, V]("TTL", c)
+}
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTTL_Expiry(t *testing.T) {
+	clock := &clock{current: time.Unix(0, 0)}
+	c := NewTTLFunc[string, int](10, time.Minute, clock.now)
+	var expired []string
+	c.OnEvict(func(key string, value int) { expired = append(expired, key) })
+
+	c.Put("a", 1)
+	clock.advance(30 * time.Second)
+	c.Put("b", 2)
+	clock.advance(30 * time.Second)
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("Expected a to expire after a minute")
+	}
+	if v, ok := c.Get("b"); !ok || *v != 2 {
+		t.Fatalf("Expected b to still be cached, got %v", v)
+	}
+	if !slices.Equal(expired, []string{"a"}) {
+		t.Fatalf("Expected a to be passed to the eviction callback, got %v", expired)
+	}
+
+	c.Put("b", 3)
+	clock.advance(45 * time.Second)
+	if !c.Contains("b") {
+		t.Fatalf("Expected Put to extend the lifetime of b")
+	}
+	clock.advance(15 * time.Second)
+	if c.Len() != 0 {
+		t.Fatalf("Expected every entry to have expired, got %v", c)
+	}
+	if stats := c.Stats(); stats.Evictions != 2 || stats.Misses != 1 {
+		t.Fatalf("Expected 2 evictions and 1 miss, got %+v", stats)
+	}
+}
+
+func TestTTL_GetDoesNotRefresh(t *testing.T) {
+	clock := &clock{current: time.Unix(0, 0)}
+	c := NewTTLFunc[string, int](10, time.Minute, clock.now)
+	c.Put("a", 1)
+	clock.advance(59 * time.Second)
+	c.Get("a")
+	clock.advance(time.Second)
+	if c.Contains("a") {
+		t.Fatalf("Expected Get to not extend the lifetime of a")
+	}
+}
+
+func TestTTL_CapacityEvictsOldest(t *testing.T) {
+	clock := &clock{current: time.Unix(0, 0)}
+	c := NewTTLFunc[string, int](2, time.Minute, clock.now)
+	c.Put("a", 1)
+	clock.advance(time.Second)
+	c.Put("b", 2)
+	c.Put("a", 3)
+	c.Put("c", 4)
+	if got := c.Keys(); !slices.Equal(got, []string{"c", "a"}) {
+		t.Fatalf("Expected b, the entry closest to expiring, to be evicted, got %v", got)
+	}
+}
+
+func TestNewTTL(t *testing.T) {
+	c := NewTTL[string, int](1, time.Hour)
+	c.Put("a", 1)
+	if !c.Contains("a") {
+		t.Fatalf("Expected a to be cached")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Expected a non-positive ttl to panic")
+		}
+	}()
+	NewTTL[string, int](1, 0)
+}
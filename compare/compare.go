@@ -0,0 +1,53 @@
+// Package compare provides comparison functions used to order the elements of
+// collections.
+package compare
+
+import "cmp"
+
+// Func compares two values. It returns a negative number when a < b, a
+// positive number when a > b and zero when a == b.
+type Func[T any] func(a, b T) int
+
+// Natural returns a Func that orders values using their natural ordering.
+func Natural[T cmp.Ordered]() Func[T] {
+	return cmp.Compare[T]
+}
+
+// Reverse returns a Func that imposes the reverse ordering of compare.
+func Reverse[T any](compare Func[T]) Func[T] {
+	return func(a, b T) int {
+		return compare(b, a)
+	}
+}
+
+// Comparing returns a Func that orders values by the natural ordering of the
+// key extracted from them.
+func Comparing[T any, K cmp.Ordered](key func(T) K) Func[T] {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// ComparingFunc returns a Func that orders values by comparing the key
+// extracted from them using compare.
+func ComparingFunc[T, K any](key func(T) K, compare Func[K]) Func[T] {
+	return func(a, b T) int {
+		return compare(key(a), key(b))
+	}
+}
+
+// ThenComparing returns a Func that orders values using first, and breaks
+// ties using each of the next functions in turn.
+func ThenComparing[T any](first Func[T], next ...Func[T]) Func[T] {
+	return func(a, b T) int {
+		if c := first(a, b); c != 0 {
+			return c
+		}
+		for _, compare := range next {
+			if c := compare(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+}
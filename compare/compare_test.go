@@ -0,0 +1,57 @@
+package compare
+
+import (
+	"slices"
+	"testing"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestNatural(t *testing.T) {
+	compare := Natural[int]()
+	if compare(1, 2) >= 0 || compare(2, 1) <= 0 || compare(1, 1) != 0 {
+		t.Fatalf("Expected Natural to order integers ascending")
+	}
+}
+
+func TestReverse(t *testing.T) {
+	values := []string{"b", "c", "a"}
+	slices.SortFunc(values, Reverse(Natural[string]()))
+	if !slices.Equal(values, []string{"c", "b", "a"}) {
+		t.Fatalf("Expected [c b a], got %v", values)
+	}
+}
+
+func TestComparing(t *testing.T) {
+	people := []person{{"b", 30}, {"a", 20}, {"c", 10}}
+	slices.SortFunc(people, Comparing(func(p person) int { return p.age }))
+	if !slices.Equal(people, []person{{"c", 10}, {"a", 20}, {"b", 30}}) {
+		t.Fatalf("Expected people sorted by age, got %v", people)
+	}
+}
+
+func TestComparingFunc(t *testing.T) {
+	people := []person{{"a", 20}, {"b", 30}}
+	slices.SortFunc(people, ComparingFunc(func(p person) int { return p.age }, Reverse(Natural[int]())))
+	if !slices.Equal(people, []person{{"b", 30}, {"a", 20}}) {
+		t.Fatalf("Expected people sorted by descending age, got %v", people)
+	}
+}
+
+func TestThenComparing(t *testing.T) {
+	people := []person{{"b", 20}, {"a", 20}, {"c", 10}, {"a", 10}}
+	byAge := Comparing(func(p person) int { return p.age })
+	byName := Comparing(func(p person) string { return p.name })
+	slices.SortFunc(people, ThenComparing(byAge, byName))
+
+	expected := []person{{"a", 10}, {"c", 10}, {"a", 20}, {"b", 20}}
+	if !slices.Equal(people, expected) {
+		t.Fatalf("Expected %v, got %v", expected, people)
+	}
+	if ThenComparing(byAge)(person{"a", 1}, person{"b", 1}) != 0 {
+		t.Fatalf("Expected ties to compare equal when there is nothing to break them")
+	}
+}
@@ -0,0 +1,51 @@
+// Package concurrent provides collections that are safe for concurrent use by
+// multiple goroutines.
+package concurrent
+
+import "fmt"
+
+// snapshotIterator iterates over a copy of the elements of a collection taken
+// when the iterator is created. Since the collection may be modified by other
+// goroutines in the meantime, removing through the iterator is not supported.
+type snapshotIterator[T any] struct {
+	values []T
+	cursor int
+}
+
+func (it *snapshotIterator[T]) HasNext() bool {
+	return it.cursor < len(it.values)
+}
+
+func (it *snapshotIterator[T]) Next() (*T, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	it.cursor++
+	return &it.values[it.cursor-1], true
+}
+
+func (it *snapshotIterator[T]) Remove() bool {
+	return false
+}
+
+func format[T any](name string, values []T) string {
+	str := name + "(["
+	for i, v := range values {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", v)
+	}
+	return str + "])"
+}
+
+// copyOf returns a pointer to a copy of the value pointed to by p, so that the
+// caller does not share memory guarded by a lock. Returns nil and false if ok
+// is false.
+func copyOf[T any](p *T, ok bool) (*T, bool) {
+	if !ok {
+		return nil, false
+	}
+	value := *p
+	return &value, true
+}
@@ -0,0 +1,202 @@
+package concurrent
+
+import (
+	"iter"
+	"sync/atomic"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+	"github.com/elias8/go-gather/queue"
+)
+
+type queueNode[T any] struct {
+	value T
+	next  atomic.Pointer[queueNode[T]]
+}
+
+// lockFreeQueue is a Michael-Scott queue: a singly linked list with a dummy
+// head node, where both ends are advanced with compare-and-swap and any
+// goroutine that finds the tail lagging behind helps to swing it forward.
+type lockFreeQueue[T any] struct {
+	head  atomic.Pointer[queueNode[T]]
+	tail  atomic.Pointer[queueNode[T]]
+	size  atomic.Int64
+	equal func(a, b T) bool
+}
+
+// NewLockFreeQueue returns an empty Queue that is safe for concurrent use
+// without locks. Elements are compared using reflect.DeepEqual.
+//
+// Size is updated separately from the queue itself, so it may briefly lag
+// behind concurrent offers and polls. Values, Contains, String and the
+// iterators observe a snapshot of the queue, and the iterator does not
+// support Remove.
+func NewLockFreeQueue[T any]() queue.Queue[T] {
+	return NewLockFreeQueueFunc(base.DeepEqual[T])
+}
+
+// NewLockFreeQueueFunc returns an empty lock-free Queue that compares elements
+// using the given equal function. If equal is nil, reflect.DeepEqual is used.
+func NewLockFreeQueueFunc[T any](equal func(a, b T) bool) queue.Queue[T] {
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	q := &lockFreeQueue[T]{equal: equal}
+	dummy := &queueNode[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// NewComparableLockFreeQueue returns an empty lock-free Queue that compares
+// elements using the == operator.
+func NewComparableLockFreeQueue[T comparable]() queue.Queue[T] {
+	return NewLockFreeQueueFunc(base.Equal[T])
+}
+
+func (q *lockFreeQueue[T]) Offer(element T) {
+	node := &queueNode[T]{value: element}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, node) {
+			q.tail.CompareAndSwap(tail, node)
+			q.size.Add(1)
+			return
+		}
+	}
+}
+
+func (q *lockFreeQueue[T]) Poll() (*T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			return nil, false
+		}
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if q.head.CompareAndSwap(head, next) {
+			q.size.Add(-1)
+			value := next.value
+			return &value, true
+		}
+	}
+}
+
+func (q *lockFreeQueue[T]) Peek() (*T, bool) {
+	next := q.head.Load().next.Load()
+	if next == nil {
+		return nil, false
+	}
+	value := next.value
+	return &value, true
+}
+
+func (q *lockFreeQueue[T]) Contains(element T) bool {
+	for current := q.head.Load().next.Load(); current != nil; current = current.next.Load() {
+		if q.equal(current.value, element) {
+			return true
+		}
+	}
+	return false
+}
+
+// Clear polls every element, so elements offered concurrently may survive
+// it.
+func (q *lockFreeQueue[T]) Clear() {
+	for {
+		if _, ok := q.Poll(); !ok {
+			return
+		}
+	}
+}
+
+func (q *lockFreeQueue[T]) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
+
+func (q *lockFreeQueue[T]) Size() int {
+	return int(max(q.size.Load(), 0))
+}
+
+func (q *lockFreeQueue[T]) Values() []T {
+	var values []T
+	for current := q.head.Load().next.Load(); current != nil; current = current.next.Load() {
+		values = append(values, current.value)
+	}
+	return values
+}
+
+func (q *lockFreeQueue[T]) String() string {
+	return format("LockFreeQueue", q.Values())
+}
+
+func (q *lockFreeQueue[T]) Iterator() base.Iterator[T] {
+	return &snapshotIterator[T]{values: q.Values()}
+}
+
+func (q *lockFreeQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range q.Values() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (q *lockFreeQueue[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(q.Values())
+}
+
+func (q *lockFreeQueue[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	q.replace(values)
+	return nil
+}
+
+func (q *lockFreeQueue[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(q.Values())
+}
+
+func (q *lockFreeQueue[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	q.replace(values)
+	return nil
+}
+
+func (q *lockFreeQueue[T]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+func (q *lockFreeQueue[T]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the queue with the decoded values.
+func (q *lockFreeQueue[T]) replace(values []T) {
+	q.Clear()
+	for _, v := range values {
+		q.Offer(v)
+	}
+}
@@ -0,0 +1,143 @@
+package concurrent
+
+import (
+	"encoding/json"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestLockFreeQueue_FIFO(t *testing.T) {
+	q := NewLockFreeQueue[int]()
+	if _, ok := q.Poll(); ok {
+		t.Fatalf("Expected Poll on an empty queue to fail")
+	}
+	if _, ok := q.Peek(); ok {
+		t.Fatalf("Expected Peek on an empty queue to fail")
+	}
+
+	q.Offer(1)
+	q.Offer(2)
+	q.Offer(3)
+	if values := q.Values(); !slices.Equal(values, []int{1, 2, 3}) {
+		t.Fatalf("Expected [1 2 3], got %v", values)
+	}
+	if head, _ := q.Peek(); *head != 1 {
+		t.Fatalf("Expected Peek to return 1, got %d", *head)
+	}
+	if head, _ := q.Poll(); *head != 1 {
+		t.Fatalf("Expected Poll to return 1, got %d", *head)
+	}
+	if q.Size() != 2 || q.IsEmpty() {
+		t.Fatalf("Expected 2 elements, got %d", q.Size())
+	}
+	if !q.Contains(3) || q.Contains(1) {
+		t.Fatalf("Expected queue to contain 3 and not 1")
+	}
+	if q.String() != "LockFreeQueue([2, 3])" {
+		t.Fatalf("Expected LockFreeQueue([2, 3]), got %s", q.String())
+	}
+	if values := slices.Collect(q.All()); !slices.Equal(values, []int{2, 3}) {
+		t.Fatalf("Expected [2 3], got %v", values)
+	}
+	if it := q.Iterator(); !it.HasNext() {
+		t.Fatalf("Expected the iterator to have elements")
+	}
+	q.Clear()
+	if !q.IsEmpty() || q.Size() != 0 {
+		t.Fatalf("Expected queue to be empty after clearing")
+	}
+	q.Offer(4)
+	if head, _ := q.Poll(); *head != 4 {
+		t.Fatalf("Expected queue to be usable after clearing")
+	}
+}
+
+func TestLockFreeQueue_Concurrent(t *testing.T) {
+	q := NewComparableLockFreeQueue[int]()
+	const producers, consumers, perProducer = 4, 4, 2000
+
+	var producing, consuming sync.WaitGroup
+	results := make([][]int, consumers)
+	done := make(chan struct{})
+
+	for p := 0; p < producers; p++ {
+		producing.Add(1)
+		go func(p int) {
+			defer producing.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Offer(p*perProducer + i)
+			}
+		}(p)
+	}
+	for c := 0; c < consumers; c++ {
+		consuming.Add(1)
+		go func(c int) {
+			defer consuming.Done()
+			last := make(map[int]int)
+			for {
+				v, ok := q.Poll()
+				if !ok {
+					select {
+					case <-done:
+						if q.IsEmpty() {
+							return
+						}
+					default:
+					}
+					continue
+				}
+				producer := *v / perProducer
+				if previous, seen := last[producer]; seen && previous > *v {
+					t.Errorf("Expected elements of producer %d in order, got %d after %d", producer, *v, previous)
+				}
+				last[producer] = *v
+				results[c] = append(results[c], *v)
+			}
+		}(c)
+	}
+
+	producing.Wait()
+	close(done)
+	consuming.Wait()
+
+	all := slices.Concat(results...)
+	slices.Sort(all)
+	if len(all) != producers*perProducer {
+		t.Fatalf("Expected %d polled elements, got %d", producers*perProducer, len(all))
+	}
+	for i, v := range all {
+		if v != i {
+			t.Fatalf("Expected every element to be polled exactly once, missing %d", i)
+		}
+	}
+}
+
+func TestLockFreeQueue_Func(t *testing.T) {
+	q := NewLockFreeQueueFunc(func(a, b string) bool { return len(a) == len(b) })
+	q.Offer("abc")
+	if !q.Contains("xyz") {
+		t.Fatalf("Expected queue to use the custom equality")
+	}
+}
+
+func TestLockFreeQueue_JSON(t *testing.T) {
+	q := NewLockFreeQueue[int]()
+	q.Offer(1)
+	q.Offer(2)
+
+	data, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	decoded := NewLockFreeQueue[int]()
+	decoded.Offer(9)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	expected := []int{1, 2}
+	if slice := decoded.Values(); !slices.Equal(slice, expected) {
+		t.Fatalf("Expected decoded queue to be %v, but got %v", expected, slice)
+	}
+}
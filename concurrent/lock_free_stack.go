@@ -0,0 +1,214 @@
+package concurrent
+
+import (
+	"hash/maphash"
+	"iter"
+	"slices"
+	"sync/atomic"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+	"github.com/elias8/go-gather/internal/hashing"
+	"github.com/elias8/go-gather/stack"
+)
+
+type stackNode[T any] struct {
+	value T
+	next  *stackNode[T]
+}
+
+// lockFreeStack is a Treiber stack: a singly linked list whose head is
+// replaced with compare-and-swap. The garbage collector guarantees that a
+// node is not reused while another goroutine still refers to it, which rules
+// out the ABA problem.
+type lockFreeStack[T any] struct {
+	head  atomic.Pointer[stackNode[T]]
+	size  atomic.Int64
+	equal func(a, b T) bool
+	hash  func(seed maphash.Seed, v T) uint64
+}
+
+// NewLockFreeStack returns an empty Stack that is safe for concurrent use
+// without locks. Elements are compared using reflect.DeepEqual and hashed
+// using base.DeepHash.
+//
+// Size is updated separately from the stack itself, so it may briefly lag
+// behind concurrent pushes and pops. Values, Contains, String and the
+// iterators observe a snapshot of the stack, and the iterator does not
+// support Remove.
+func NewLockFreeStack[T any]() stack.Stack[T] {
+	return NewLockFreeStackFunc(base.DeepEqual[T], base.DeepHash[T])
+}
+
+// NewLockFreeStackFunc returns an empty lock-free Stack that compares elements
+// using the given equal function and hashes them using the given hash
+// function, which must return the same hash for elements that equal reports
+// equal. If equal is nil, reflect.DeepEqual is used, and if hash is nil,
+// base.DeepHash is used.
+func NewLockFreeStackFunc[T any](equal func(a, b T) bool, hash func(seed maphash.Seed, v T) uint64) stack.Stack[T] {
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	if hash == nil {
+		hash = base.DeepHash[T]
+	}
+	return &lockFreeStack[T]{equal: equal, hash: hash}
+}
+
+// NewComparableLockFreeStack returns an empty lock-free Stack that compares
+// elements using the == operator and hashes them using maphash.Comparable.
+func NewComparableLockFreeStack[T comparable]() stack.Stack[T] {
+	return NewLockFreeStackFunc(base.Equal[T], base.Hash[T])
+}
+
+func (s *lockFreeStack[T]) Push(element T) {
+	node := &stackNode[T]{value: element}
+	for {
+		node.next = s.head.Load()
+		if s.head.CompareAndSwap(node.next, node) {
+			s.size.Add(1)
+			return
+		}
+	}
+}
+
+func (s *lockFreeStack[T]) Pop() (*T, bool) {
+	for {
+		head := s.head.Load()
+		if head == nil {
+			return nil, false
+		}
+		if s.head.CompareAndSwap(head, head.next) {
+			s.size.Add(-1)
+			value := head.value
+			return &value, true
+		}
+	}
+}
+
+func (s *lockFreeStack[T]) Peek() (*T, bool) {
+	head := s.head.Load()
+	if head == nil {
+		return nil, false
+	}
+	value := head.value
+	return &value, true
+}
+
+func (s *lockFreeStack[T]) Contains(element T) bool {
+	for current := s.head.Load(); current != nil; current = current.next {
+		if s.equal(current.value, element) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *lockFreeStack[T]) Clear() {
+	removed := int64(0)
+	for current := s.head.Swap(nil); current != nil; current = current.next {
+		removed++
+	}
+	s.size.Add(-removed)
+}
+
+func (s *lockFreeStack[T]) IsEmpty() bool {
+	return s.head.Load() == nil
+}
+
+func (s *lockFreeStack[T]) Size() int {
+	return int(max(s.size.Load(), 0))
+}
+
+// Values returns the elements from the bottom of the stack to the top, like
+// the other stacks.
+func (s *lockFreeStack[T]) Values() []T {
+	var values []T
+	for current := s.head.Load(); current != nil; current = current.next {
+		values = append(values, current.value)
+	}
+	slices.Reverse(values)
+	return values
+}
+
+func (s *lockFreeStack[T]) String() string {
+	return format("LockFreeStack", s.Values())
+}
+
+func (s *lockFreeStack[T]) Iterator() base.Iterator[T] {
+	return &snapshotIterator[T]{values: s.Values()}
+}
+
+func (s *lockFreeStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.Values() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *lockFreeStack[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range slices.Backward(s.Values()) {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *lockFreeStack[T]) Equals(other base.Collection[T]) bool {
+	o, ok := other.(stack.Stack[T])
+	if !ok {
+		return false
+	}
+	return slices.EqualFunc(s.Values(), o.Values(), s.equal)
+}
+
+func (s *lockFreeStack[T]) Hash(seed maphash.Seed) uint64 {
+	return hashing.Ordered(seed, slices.Values(s.Values()), s.hash)
+}
+
+func (s *lockFreeStack[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(s.Values())
+}
+
+func (s *lockFreeStack[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *lockFreeStack[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(s.Values())
+}
+
+func (s *lockFreeStack[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *lockFreeStack[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+func (s *lockFreeStack[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the stack with the decoded values.
+func (s *lockFreeStack[T]) replace(values []T) {
+	s.Clear()
+	for _, v := range values {
+		s.Push(v)
+	}
+}
@@ -0,0 +1,169 @@
+package concurrent
+
+import (
+	"bytes"
+	"encoding/gob"
+	"hash/maphash"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/elias8/go-gather/stack"
+)
+
+func TestLockFreeStack_LIFO(t *testing.T) {
+	s := NewLockFreeStack[int]()
+	if _, ok := s.Pop(); ok {
+		t.Fatalf("Expected Pop on an empty stack to fail")
+	}
+	if _, ok := s.Peek(); ok {
+		t.Fatalf("Expected Peek on an empty stack to fail")
+	}
+
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+	if values := s.Values(); !slices.Equal(values, []int{1, 2, 3}) {
+		t.Fatalf("Expected [1 2 3], got %v", values)
+	}
+	if top, _ := s.Peek(); *top != 3 {
+		t.Fatalf("Expected Peek to return 3, got %d", *top)
+	}
+	if top, _ := s.Pop(); *top != 3 {
+		t.Fatalf("Expected Pop to return 3, got %d", *top)
+	}
+	if s.Size() != 2 || s.IsEmpty() {
+		t.Fatalf("Expected 2 elements, got %d", s.Size())
+	}
+	if !s.Contains(1) || s.Contains(3) {
+		t.Fatalf("Expected stack to contain 1 and not 3")
+	}
+	if s.String() != "LockFreeStack([1, 2])" {
+		t.Fatalf("Expected LockFreeStack([1, 2]), got %s", s.String())
+	}
+	var backward []int
+	for _, v := range s.Backward() {
+		backward = append(backward, v)
+	}
+	if !slices.Equal(backward, []int{2, 1}) {
+		t.Fatalf("Expected [2 1], got %v", backward)
+	}
+	s.Clear()
+	if !s.IsEmpty() || s.Size() != 0 {
+		t.Fatalf("Expected stack to be empty after clearing")
+	}
+}
+
+func TestLockFreeStack_Concurrent(t *testing.T) {
+	s := NewComparableLockFreeStack[int]()
+	const goroutines, perGoroutine = 8, 1000
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				s.Push(g*perGoroutine + i)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if s.Size() != goroutines*perGoroutine {
+		t.Fatalf("Expected %d elements, got %d", goroutines*perGoroutine, s.Size())
+	}
+
+	popped := make([][]int, goroutines)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for {
+				v, ok := s.Pop()
+				if !ok {
+					return
+				}
+				popped[g] = append(popped[g], *v)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	all := slices.Concat(popped...)
+	slices.Sort(all)
+	if len(all) != goroutines*perGoroutine {
+		t.Fatalf("Expected %d popped elements, got %d", goroutines*perGoroutine, len(all))
+	}
+	for i, v := range all {
+		if v != i {
+			t.Fatalf("Expected every element to be popped exactly once, missing %d", i)
+		}
+	}
+	if !s.IsEmpty() {
+		t.Fatalf("Expected stack to be empty")
+	}
+}
+
+func TestLockFreeStack_Iterator(t *testing.T) {
+	s := NewLockFreeStackFunc[int](nil, nil)
+	s.Push(1)
+	s.Push(2)
+
+	it := s.Iterator()
+	var values []int
+	for it.HasNext() {
+		v, _ := it.Next()
+		values = append(values, *v)
+	}
+	if !slices.Equal(values, []int{1, 2}) {
+		t.Fatalf("Expected [1 2], got %v", values)
+	}
+	if it.Remove() {
+		t.Fatalf("Expected Remove to not be supported")
+	}
+	if values := slices.Collect(s.All()); !slices.Equal(values, []int{1, 2}) {
+		t.Fatalf("Expected [1 2], got %v", values)
+	}
+}
+
+func TestLockFreeStack_Gob(t *testing.T) {
+	s := NewLockFreeStack[int]()
+	s.Push(1)
+	s.Push(2)
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(s); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	decoded := NewLockFreeStack[int]()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if v, _ := decoded.Pop(); *v != 2 {
+		t.Fatalf("Expected decoded stack to have 2 on top, but got %v", *v)
+	}
+	if decoded.Size() != 1 {
+		t.Fatalf("Expected decoded stack to have 1 element left, but got %v", decoded.Size())
+	}
+}
+
+func TestLockFreeStack_Equals(t *testing.T) {
+	s := NewLockFreeStack[int]()
+	synchronized := SynchronizedStack(stack.New[int]())
+	for i := range 3 {
+		s.Push(i)
+		synchronized.Push(i)
+	}
+	if !s.Equals(synchronized) || !synchronized.Equals(s) {
+		t.Fatalf("Expected stacks with the same elements to be equal")
+	}
+	seed := maphash.MakeSeed()
+	if s.Hash(seed) != synchronized.Hash(seed) {
+		t.Fatalf("Expected equal stacks to have the same hash")
+	}
+	synchronized.Pop()
+	if s.Equals(synchronized) {
+		t.Fatalf("Expected stacks of different sizes to not be equal")
+	}
+}
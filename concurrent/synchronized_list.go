@@ -0,0 +1,243 @@
+package concurrent
+
+import (
+	"hash/maphash"
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+	"github.com/elias8/go-gather/list"
+)
+
+type synchronizedList[T any] struct {
+	mu   *sync.RWMutex
+	list list.List[T]
+}
+
+// SynchronizedList returns a List that guards every operation on l with a
+// read-write mutex. All access to l must go through the returned list.
+//
+// Elements returned by Get, RemoveAt and Set are copies. Iterator, All and
+// Backward iterate over a snapshot of the list taken when they are called, and
+// the iterator does not support Remove. Views returned by SubList are guarded
+// by the same mutex.
+func SynchronizedList[T any](l list.List[T]) list.List[T] {
+	return &synchronizedList[T]{mu: &sync.RWMutex{}, list: l}
+}
+
+func (s *synchronizedList[T]) Contains(element T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Contains(element)
+}
+
+func (s *synchronizedList[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Clear()
+}
+
+func (s *synchronizedList[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.IsEmpty()
+}
+
+func (s *synchronizedList[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Size()
+}
+
+func (s *synchronizedList[T]) Values() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Values()
+}
+
+func (s *synchronizedList[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.String()
+}
+
+func (s *synchronizedList[T]) Iterator() base.Iterator[T] {
+	return &snapshotIterator[T]{values: s.Values()}
+}
+
+func (s *synchronizedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.Values() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *synchronizedList[T]) Add(element T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Add(element)
+}
+
+func (s *synchronizedList[T]) AddAt(index int, element T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.AddAt(index, element)
+}
+
+func (s *synchronizedList[T]) AddAll(elements ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.AddAll(elements...)
+}
+
+func (s *synchronizedList[T]) AddAllAt(index int, elements ...T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.AddAllAt(index, elements...)
+}
+
+func (s *synchronizedList[T]) Remove(element T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Remove(element)
+}
+
+func (s *synchronizedList[T]) RemoveAt(index int) (*T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyOf(s.list.RemoveAt(index))
+}
+
+func (s *synchronizedList[T]) RemoveRange(from, to int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveRange(from, to)
+}
+
+func (s *synchronizedList[T]) RemoveIf(predicate func(element T) bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveIf(predicate)
+}
+
+func (s *synchronizedList[T]) Set(index int, element T) (*T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyOf(s.list.Set(index, element))
+}
+
+func (s *synchronizedList[T]) Get(index int) (*T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return copyOf(s.list.Get(index))
+}
+
+func (s *synchronizedList[T]) IndexOf(element T) (int, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.IndexOf(element)
+}
+
+func (s *synchronizedList[T]) LastIndexOf(element T) (int, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.LastIndexOf(element)
+}
+
+func (s *synchronizedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range slices.Backward(s.Values()) {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *synchronizedList[T]) Sort(compare func(a, b T) int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Sort(compare)
+}
+
+func (s *synchronizedList[T]) SortStable(compare func(a, b T) int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.SortStable(compare)
+}
+
+func (s *synchronizedList[T]) SubList(from, to int) (list.List[T], bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sub, ok := s.list.SubList(from, to)
+	if !ok {
+		return nil, false
+	}
+	return &synchronizedList[T]{mu: s.mu, list: sub}, true
+}
+
+// Equals takes a snapshot of other before locking the list, so that the
+// mutexes of the two lists are never held at the same time.
+func (s *synchronizedList[T]) Equals(other base.Collection[T]) bool {
+	o, ok := other.(list.List[T])
+	if !ok {
+		return false
+	}
+	snapshot := list.NewArrayListFrom(o.Values())
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Equals(snapshot)
+}
+
+func (s *synchronizedList[T]) Hash(seed maphash.Seed) uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Hash(seed)
+}
+
+func (s *synchronizedList[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(s.Values())
+}
+
+func (s *synchronizedList[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *synchronizedList[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(s.Values())
+}
+
+func (s *synchronizedList[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *synchronizedList[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+func (s *synchronizedList[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the list with the decoded values.
+func (s *synchronizedList[T]) replace(values []T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Clear()
+	s.list.AddAll(values...)
+}
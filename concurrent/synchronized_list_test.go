@@ -0,0 +1,191 @@
+package concurrent
+
+import (
+	"encoding/json"
+	"hash/maphash"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/elias8/go-gather/list"
+)
+
+func TestSynchronizedList_ConcurrentAdd(t *testing.T) {
+	l := SynchronizedList(list.NewArrayList[int]())
+	const goroutines, perGoroutine = 8, 500
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				l.Add(g*perGoroutine + i)
+				l.Contains(i)
+				l.Get(i)
+				for range l.All() {
+					break
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if l.Size() != goroutines*perGoroutine {
+		t.Fatalf("Expected %d elements, got %d", goroutines*perGoroutine, l.Size())
+	}
+	values := l.Values()
+	slices.Sort(values)
+	for i, v := range values {
+		if v != i {
+			t.Fatalf("Expected element %d at index %d, got %d", i, i, v)
+		}
+	}
+}
+
+func TestSynchronizedList_ConcurrentRemove(t *testing.T) {
+	l := SynchronizedList(list.NewLinkedList[int]())
+	for i := 0; i < 1000; i++ {
+		l.Add(i)
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := g; i < 1000; i += 4 {
+				if !l.Remove(i) {
+					t.Errorf("Expected %d to be removed", i)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if !l.IsEmpty() {
+		t.Fatalf("Expected list to be empty, got %v", l.Values())
+	}
+}
+
+func TestSynchronizedList_Delegation(t *testing.T) {
+	l := SynchronizedList(list.NewArrayList[int]())
+	l.AddAll(3, 1, 2)
+	l.AddAt(0, 4)
+	l.AddAllAt(1, 5, 6)
+	l.Sort(func(a, b int) int { return a - b })
+
+	if values := l.Values(); !slices.Equal(values, []int{1, 2, 3, 4, 5, 6}) {
+		t.Fatalf("Expected [1 2 3 4 5 6], got %v", values)
+	}
+	if v, _ := l.Set(0, 0); *v != 1 {
+		t.Fatalf("Expected Set to return 1, got %d", *v)
+	}
+	if v, _ := l.RemoveAt(0); *v != 0 {
+		t.Fatalf("Expected RemoveAt to return 0, got %d", *v)
+	}
+	l.RemoveRange(0, 1)
+	l.RemoveIf(func(v int) bool { return v == 6 })
+	l.SortStable(func(a, b int) int { return b - a })
+	if i, _ := l.IndexOf(4); i != 1 {
+		t.Fatalf("Expected index of 4 to be 1, got %d", i)
+	}
+	if i, _ := l.LastIndexOf(3); i != 2 {
+		t.Fatalf("Expected last index of 3 to be 2, got %d", i)
+	}
+	if l.String() != "ArrayList([5, 4, 3])" {
+		t.Fatalf("Expected ArrayList([5, 4, 3]), got %s", l.String())
+	}
+
+	var backward []int
+	for _, v := range l.Backward() {
+		backward = append(backward, v)
+	}
+	if !slices.Equal(backward, []int{3, 4, 5}) {
+		t.Fatalf("Expected [3 4 5], got %v", backward)
+	}
+
+	it := l.Iterator()
+	for it.HasNext() {
+		it.Next()
+		if it.Remove() {
+			t.Fatalf("Expected the snapshot iterator to not support Remove")
+		}
+	}
+	l.Clear()
+	if l.Size() != 0 {
+		t.Fatalf("Expected list to be empty after clearing")
+	}
+}
+
+func TestSynchronizedList_GetReturnsCopy(t *testing.T) {
+	l := SynchronizedList(list.NewArrayList[int]())
+	l.Add(1)
+	v, _ := l.Get(0)
+	*v = 2
+	if v, _ := l.Get(0); *v != 1 {
+		t.Fatalf("Expected modifying the returned element to not affect the list")
+	}
+}
+
+func TestSynchronizedList_JSON(t *testing.T) {
+	l := SynchronizedList(list.NewArrayList[int]())
+	l.AddAll(1, 2, 3)
+
+	data, err := json.Marshal(l)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	decoded := SynchronizedList(list.NewLinkedList[int]())
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	expected := []int{1, 2, 3}
+	if slice := decoded.Values(); !slices.Equal(slice, expected) {
+		t.Fatalf("Expected decoded list to be %v, but got %v", expected, slice)
+	}
+}
+
+func TestSynchronizedList_SubList(t *testing.T) {
+	l := SynchronizedList(list.NewArrayList[int]())
+	l.AddAll(0, 1, 2, 3)
+	view, ok := l.SubList(1, 3)
+	if !ok {
+		t.Fatalf("Expected SubList(1, 3) to succeed")
+	}
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			view.Set(i%2, i)
+			view.Get(0)
+		}()
+	}
+	wg.Wait()
+
+	if l.Size() != 4 || view.Size() != 2 {
+		t.Fatalf("Expected sizes 4 and 2, but got %v and %v", l.Size(), view.Size())
+	}
+}
+
+func TestSynchronizedList_Equals(t *testing.T) {
+	l := SynchronizedList(list.NewArrayList[int]())
+	l.AddAll(1, 2, 3)
+	sub, _ := l.SubList(0, 3)
+	other := list.NewLinkedList[int]()
+	other.AddAll(1, 2, 3)
+
+	if !l.Equals(other) || !other.Equals(l) {
+		t.Fatalf("Expected a synchronized list to equal a list with the same elements")
+	}
+	if !l.Equals(l) || !l.Equals(sub) {
+		t.Fatalf("Expected a synchronized list to equal itself and a view sharing its mutex")
+	}
+	seed := maphash.MakeSeed()
+	if l.Hash(seed) != other.Hash(seed) {
+		t.Fatalf("Expected equal lists to have the same hash")
+	}
+}
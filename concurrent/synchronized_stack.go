@@ -0,0 +1,171 @@
+package concurrent
+
+import (
+	"hash/maphash"
+	"iter"
+	"slices"
+	"sync"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+	"github.com/elias8/go-gather/stack"
+)
+
+type synchronizedStack[T any] struct {
+	mu    sync.RWMutex
+	stack stack.Stack[T]
+}
+
+// SynchronizedStack returns a Stack that guards every operation on s with a
+// read-write mutex. All access to s must go through the returned stack.
+//
+// Elements returned by Pop and Peek are copies. Iterator, All and Backward
+// iterate over a snapshot of the stack taken when they are called, and the
+// iterator does not support Remove.
+func SynchronizedStack[T any](s stack.Stack[T]) stack.Stack[T] {
+	return &synchronizedStack[T]{stack: s}
+}
+
+func (s *synchronizedStack[T]) Contains(element T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.Contains(element)
+}
+
+func (s *synchronizedStack[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Clear()
+}
+
+func (s *synchronizedStack[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.IsEmpty()
+}
+
+func (s *synchronizedStack[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.Size()
+}
+
+func (s *synchronizedStack[T]) Values() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.Values()
+}
+
+func (s *synchronizedStack[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.String()
+}
+
+func (s *synchronizedStack[T]) Iterator() base.Iterator[T] {
+	return &snapshotIterator[T]{values: s.Values()}
+}
+
+func (s *synchronizedStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.Values() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *synchronizedStack[T]) Push(element T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Push(element)
+}
+
+func (s *synchronizedStack[T]) Pop() (*T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyOf(s.stack.Pop())
+}
+
+func (s *synchronizedStack[T]) Peek() (*T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return copyOf(s.stack.Peek())
+}
+
+func (s *synchronizedStack[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range slices.Backward(s.Values()) {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Equals takes a snapshot of other before locking the stack, so that the
+// mutexes of the two stacks are never held at the same time.
+func (s *synchronizedStack[T]) Equals(other base.Collection[T]) bool {
+	o, ok := other.(stack.Stack[T])
+	if !ok {
+		return false
+	}
+	snapshot := stack.New[T]()
+	for _, v := range o.Values() {
+		snapshot.Push(v)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.Equals(snapshot)
+}
+
+func (s *synchronizedStack[T]) Hash(seed maphash.Seed) uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stack.Hash(seed)
+}
+
+func (s *synchronizedStack[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(s.Values())
+}
+
+func (s *synchronizedStack[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *synchronizedStack[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(s.Values())
+}
+
+func (s *synchronizedStack[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *synchronizedStack[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+func (s *synchronizedStack[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the stack with the decoded values.
+func (s *synchronizedStack[T]) replace(values []T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Clear()
+	for _, v := range values {
+		s.stack.Push(v)
+	}
+}
@@ -0,0 +1,68 @@
+package concurrent
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/elias8/go-gather/stack"
+)
+
+func TestSynchronizedStack_ConcurrentPushPop(t *testing.T) {
+	s := SynchronizedStack(stack.New[int]())
+	const goroutines, perGoroutine = 8, 500
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				s.Push(i)
+				s.Peek()
+			}
+			for i := 0; i < perGoroutine/2; i++ {
+				if _, ok := s.Pop(); !ok {
+					t.Errorf("Expected Pop to find an element")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Size() != goroutines*perGoroutine/2 {
+		t.Fatalf("Expected %d elements, got %d", goroutines*perGoroutine/2, s.Size())
+	}
+}
+
+func TestSynchronizedStack_Delegation(t *testing.T) {
+	s := SynchronizedStack(stack.NewComparable[int]())
+	if !s.IsEmpty() {
+		t.Fatalf("Expected stack to be empty")
+	}
+	s.Push(1)
+	s.Push(2)
+	if !s.Contains(2) || s.Contains(3) {
+		t.Fatalf("Expected stack to contain 2 and not 3")
+	}
+	if s.String() != "Stack([1, 2])" {
+		t.Fatalf("Expected Stack([1, 2]), got %s", s.String())
+	}
+	if values := slices.Collect(s.All()); !slices.Equal(values, []int{1, 2}) {
+		t.Fatalf("Expected [1 2], got %v", values)
+	}
+	var backward []int
+	for _, v := range s.Backward() {
+		backward = append(backward, v)
+	}
+	if !slices.Equal(backward, []int{2, 1}) {
+		t.Fatalf("Expected [2 1], got %v", backward)
+	}
+	if it := s.Iterator(); !it.HasNext() {
+		t.Fatalf("Expected the iterator to have elements")
+	}
+	s.Clear()
+	if _, ok := s.Pop(); ok {
+		t.Fatalf("Expected stack to be empty after clearing")
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/elias8/go-gather
 
-go 1.21
+go 1.24
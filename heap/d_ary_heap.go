@@ -0,0 +1,246 @@
+package heap
+
+import (
+	"cmp"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// dAryHeap is a PriorityQueue backed by an implicit d-ary heap stored in a
+// slice, where the children of the element at index i are at indices d*i+1
+// through d*i+d. A binary heap is a d-ary heap with d equal to 2.
+type dAryHeap[T any] struct {
+	elements []T
+	arity    int
+	compare  func(a, b T) int
+	equal    func(a, b T) bool
+}
+
+// New returns an empty PriorityQueue backed by a binary heap that orders its
+// elements using their natural ordering and compares them using the ==
+// operator.
+func New[T cmp.Ordered]() PriorityQueue[T] {
+	return NewComparableFunc(cmp.Compare[T])
+}
+
+// NewFunc returns an empty PriorityQueue backed by a binary heap that orders
+// its elements using the given compare function, which returns a negative
+// number when a < b, a positive number when a > b and zero when a == b, and
+// compares them using the given equal function. If equal is nil,
+// reflect.DeepEqual is used.
+func NewFunc[T any](compare func(a, b T) int, equal func(a, b T) bool) PriorityQueue[T] {
+	return NewDAryFunc(2, compare, equal)
+}
+
+// NewComparableFunc returns an empty PriorityQueue backed by a binary heap
+// that orders its elements using the given compare function and compares them
+// using the == operator.
+func NewComparableFunc[T comparable](compare func(a, b T) int) PriorityQueue[T] {
+	return NewDAryFunc(2, compare, base.Equal[T])
+}
+
+// NewDAry returns an empty PriorityQueue backed by a d-ary heap that orders
+// its elements using their natural ordering and compares them using the ==
+// operator. It panics if d is less than 2.
+func NewDAry[T cmp.Ordered](d int) PriorityQueue[T] {
+	return NewComparableDAryFunc(d, cmp.Compare[T])
+}
+
+// NewDAryFunc returns an empty PriorityQueue backed by a d-ary heap that
+// orders its elements using the given compare function and compares them
+// using the given equal function. If equal is nil, reflect.DeepEqual is used.
+// A larger d makes Offer cheaper and Poll more expensive, which suits
+// workloads that offer far more often than they poll. It panics if d is less
+// than 2.
+func NewDAryFunc[T any](d int, compare func(a, b T) int, equal func(a, b T) bool) PriorityQueue[T] {
+	if d < 2 {
+		panic("heap: arity must be at least 2")
+	}
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	return &dAryHeap[T]{arity: d, compare: compare, equal: equal}
+}
+
+// NewComparableDAryFunc returns an empty PriorityQueue backed by a d-ary heap
+// that orders its elements using the given compare function and compares them
+// using the == operator. It panics if d is less than 2.
+func NewComparableDAryFunc[T comparable](d int, compare func(a, b T) int) PriorityQueue[T] {
+	return NewDAryFunc(d, compare, base.Equal[T])
+}
+
+func (h *dAryHeap[T]) Size() int {
+	return len(h.elements)
+}
+
+func (h *dAryHeap[T]) IsEmpty() bool {
+	return len(h.elements) == 0
+}
+
+func (h *dAryHeap[T]) Contains(element T) bool {
+	return h.indexOf(element) >= 0
+}
+
+func (h *dAryHeap[T]) Values() []T {
+	return append([]T(nil), h.elements...)
+}
+
+func (h *dAryHeap[T]) Clear() {
+	clear(h.elements)
+	h.elements = h.elements[:0]
+}
+
+func (h *dAryHeap[T]) String() string {
+	return format("PriorityQueue", h.elements)
+}
+
+func (h *dAryHeap[T]) Iterator() base.Iterator[T] {
+	return newSnapshotIterator(h.Values(), h.remove)
+}
+
+func (h *dAryHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, e := range h.elements {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+func (h *dAryHeap[T]) Offer(element T) {
+	h.elements = append(h.elements, element)
+	h.up(len(h.elements) - 1)
+}
+
+func (h *dAryHeap[T]) Poll() (*T, bool) {
+	if len(h.elements) == 0 {
+		return nil, false
+	}
+	removed := h.removeAt(0)
+	return &removed, true
+}
+
+func (h *dAryHeap[T]) Peek() (*T, bool) {
+	if len(h.elements) == 0 {
+		return nil, false
+	}
+	top := h.elements[0]
+	return &top, true
+}
+
+// remove removes the first element that is equal to element.
+func (h *dAryHeap[T]) remove(element T) bool {
+	i := h.indexOf(element)
+	if i < 0 {
+		return false
+	}
+	h.removeAt(i)
+	return true
+}
+
+func (h *dAryHeap[T]) indexOf(element T) int {
+	for i, e := range h.elements {
+		if h.equal(e, element) {
+			return i
+		}
+	}
+	return -1
+}
+
+// removeAt removes the element at index i by moving the last element into its
+// place and restoring the heap order around it.
+func (h *dAryHeap[T]) removeAt(i int) T {
+	last := len(h.elements) - 1
+	removed := h.elements[i]
+	h.elements[i] = h.elements[last]
+	var zero T
+	h.elements[last] = zero
+	h.elements = h.elements[:last]
+	if i < last {
+		h.down(i)
+		h.up(i)
+	}
+	return removed
+}
+
+// up moves the element at index i towards the root while it is smaller than
+// its parent.
+func (h *dAryHeap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / h.arity
+		if h.compare(h.elements[i], h.elements[parent]) >= 0 {
+			return
+		}
+		h.elements[i], h.elements[parent] = h.elements[parent], h.elements[i]
+		i = parent
+	}
+}
+
+// down moves the element at index i towards the leaves while it is larger than
+// its smallest child.
+func (h *dAryHeap[T]) down(i int) {
+	n := len(h.elements)
+	for {
+		first := h.arity*i + 1
+		if first >= n {
+			return
+		}
+		smallest := first
+		for c := first + 1; c < first+h.arity && c < n; c++ {
+			if h.compare(h.elements[c], h.elements[smallest]) < 0 {
+				smallest = c
+			}
+		}
+		if h.compare(h.elements[smallest], h.elements[i]) >= 0 {
+			return
+		}
+		h.elements[i], h.elements[smallest] = h.elements[smallest], h.elements[i]
+		i = smallest
+	}
+}
+
+func (h *dAryHeap[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(h.Values())
+}
+
+func (h *dAryHeap[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	h.replace(values)
+	return nil
+}
+
+func (h *dAryHeap[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(h.Values())
+}
+
+func (h *dAryHeap[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	h.replace(values)
+	return nil
+}
+
+func (h *dAryHeap[T]) GobEncode() ([]byte, error) {
+	return h.MarshalBinary()
+}
+
+func (h *dAryHeap[T]) GobDecode(data []byte) error {
+	return h.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the heap with the decoded values, heapifying
+// them bottom-up in O(n) time.
+func (h *dAryHeap[T]) replace(values []T) {
+	h.elements = values
+	for i := (len(values) - 2) / h.arity; i >= 0; i-- {
+		h.down(i)
+	}
+}
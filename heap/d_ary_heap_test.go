@@ -0,0 +1,195 @@
+package heap
+
+import (
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/elias8/go-gather/compare"
+)
+
+// drain polls every element of the queue and returns them in polling order.
+func drain[T any](q interface{ Poll() (*T, bool) }) []T {
+	var values []T
+	for v, ok := q.Poll(); ok; v, ok = q.Poll() {
+		values = append(values, *v)
+	}
+	return values
+}
+
+func TestNew(t *testing.T) {
+	q := New[int]()
+
+	if q == nil {
+		t.Fatalf("Expected queue to not be nil")
+	}
+	if !q.IsEmpty() {
+		t.Fatalf("Expected queue to be empty")
+	}
+	if _, ok := q.Peek(); ok {
+		t.Fatalf("Expected Peek on an empty queue to fail")
+	}
+	if _, ok := q.Poll(); ok {
+		t.Fatalf("Expected Poll on an empty queue to fail")
+	}
+}
+
+func TestNewDAryFunc_InvalidArity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Expected NewDAryFunc to panic for an arity of 1")
+		}
+	}()
+	NewDAryFunc(1, compare.Natural[int](), nil)
+}
+
+func TestPriorityQueue_Poll(t *testing.T) {
+	scenarios := []struct {
+		name  string
+		queue PriorityQueue[int]
+	}{
+		{name: "binary heap", queue: New[int]()},
+		{name: "3-ary heap", queue: NewDAry[int](3)},
+		{name: "8-ary heap", queue: NewDAry[int](8)},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+			var expected []int
+			for range 200 {
+				v := r.Intn(50)
+				expected = append(expected, v)
+				s.queue.Offer(v)
+			}
+			slices.Sort(expected)
+
+			if s.queue.Size() != len(expected) {
+				t.Fatalf("Expected queue to have %v elements, but got %v", len(expected), s.queue.Size())
+			}
+			if v, _ := s.queue.Peek(); *v != expected[0] {
+				t.Fatalf("Expected Peek to return %v, but got %v", expected[0], *v)
+			}
+			if values := drain[int](s.queue); !slices.Equal(values, expected) {
+				t.Fatalf("Expected elements to be polled in order %v, but got %v", expected, values)
+			}
+		})
+	}
+}
+
+func TestPriorityQueue_MaxHeap(t *testing.T) {
+	q := NewComparableFunc(compare.Reverse(compare.Natural[string]()))
+	q.Offer("b")
+	q.Offer("c")
+	q.Offer("a")
+
+	expected := []string{"c", "b", "a"}
+	if values := drain[string](q); !slices.Equal(values, expected) {
+		t.Fatalf("Expected elements to be polled in order %v, but got %v", expected, values)
+	}
+}
+
+func TestPriorityQueue_Contains(t *testing.T) {
+	q := New[int]()
+	q.Offer(2)
+	q.Offer(1)
+
+	if !q.Contains(2) {
+		t.Fatalf("Expected queue to contain 2")
+	}
+	if q.Contains(3) {
+		t.Fatalf("Expected queue to not contain 3")
+	}
+
+	t.Run("custom equality", func(t *testing.T) {
+		type task struct {
+			id       int
+			priority int
+		}
+		q := NewFunc(
+			compare.Comparing(func(t task) int { return t.priority }),
+			func(a, b task) bool { return a.id == b.id },
+		)
+		q.Offer(task{id: 1, priority: 5})
+		q.Offer(task{id: 2, priority: 5})
+
+		if !q.Contains(task{id: 2, priority: 1}) {
+			t.Fatalf("Expected queue to contain the task with id 2")
+		}
+		if q.Contains(task{id: 3, priority: 5}) {
+			t.Fatalf("Expected queue to not contain a task of equal priority with another id")
+		}
+	})
+}
+
+func TestPriorityQueue_Peek(t *testing.T) {
+	q := New[int]()
+	q.Offer(1)
+	q.Offer(2)
+
+	top, _ := q.Peek()
+	*top = 3
+	if v, _ := q.Poll(); *v != 1 {
+		t.Fatalf("Expected modifying the result of Peek to leave the queue unchanged, but polled %v", *v)
+	}
+}
+
+func TestPriorityQueue_Clear(t *testing.T) {
+	q := New[int]()
+	q.Offer(2)
+	q.Offer(1)
+	q.Clear()
+
+	if !q.IsEmpty() {
+		t.Fatalf("Expected queue to be empty after clearing")
+	}
+	if q.String() != "PriorityQueue([])" {
+		t.Fatalf("Expected PriorityQueue([]), but got %v", q.String())
+	}
+}
+
+func TestPriorityQueue_String(t *testing.T) {
+	q := New[int]()
+	q.Offer(3)
+	q.Offer(1)
+	q.Offer(2)
+
+	if q.String() != "PriorityQueue([1, 3, 2])" {
+		t.Fatalf("Expected PriorityQueue([1, 3, 2]), but got %v", q.String())
+	}
+}
+
+func TestPriorityQueue_Iterator(t *testing.T) {
+	q := New[int]()
+	for _, v := range []int{5, 3, 8, 1, 4} {
+		q.Offer(v)
+	}
+
+	it := q.Iterator()
+	for it.HasNext() {
+		if v, _ := it.Next(); *v%2 == 0 {
+			it.Remove()
+		}
+	}
+	if it.Remove() {
+		t.Fatalf("Expected Remove to fail when called twice")
+	}
+
+	expected := []int{1, 3, 5}
+	if values := drain[int](q); !slices.Equal(values, expected) {
+		t.Fatalf("Expected %v after removing even elements, but got %v", expected, values)
+	}
+}
+
+func TestPriorityQueue_JSON(t *testing.T) {
+	q := New[int]()
+	if err := json.Unmarshal([]byte("[5, 3, 8, 1, 4, 1]"), q); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	expected := []int{1, 1, 3, 4, 5, 8}
+	if values := drain[int](q); !slices.Equal(values, expected) {
+		t.Fatalf("Expected decoded queue to poll %v, but got %v", expected, values)
+	}
+}
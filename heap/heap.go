@@ -0,0 +1,126 @@
+// Package heap provides priority queues that always yield their smallest
+// element first, as ordered by a compare function. Pass a reversed compare
+// function, such as one returned by compare.Reverse, to build a max-heap.
+package heap
+
+import (
+	"fmt"
+
+	"github.com/elias8/go-gather/base"
+)
+
+// PriorityQueue is a queue whose head is always its smallest element according
+// to the compare function it was created with. Elements of equal priority are
+// polled in no particular order. Values, All and Iterator visit the elements in
+// no particular order, and Contains compares elements using the equality the
+// queue was created with, which is unrelated to their priority.
+type PriorityQueue[T any] interface {
+	base.Collection[T]
+
+	// Offer adds an element to the queue.
+	Offer(element T)
+
+	// Poll removes and returns the smallest element of the queue. Returns nil
+	// and false if the queue is empty.
+	Poll() (*T, bool)
+
+	// Peek returns a copy of the smallest element of the queue without
+	// removing it, so modifying it does not affect the order of the queue.
+	// Returns nil and false if the queue is empty.
+	Peek() (*T, bool)
+}
+
+// MergeablePriorityQueue is a PriorityQueue that can absorb the elements of
+// another queue efficiently.
+type MergeablePriorityQueue[T any] interface {
+	PriorityQueue[T]
+
+	// Merge moves all the elements of other into the queue, leaving other
+	// empty. Merging two pairing heaps that order their elements naturally,
+	// such as those created by NewPairing, takes O(1) time. Otherwise, since
+	// compare functions cannot be compared, the queue cannot tell whether
+	// other is ordered the same way, and the elements of other are offered to
+	// the queue one by one.
+	Merge(other MergeablePriorityQueue[T])
+}
+
+// IndexedPriorityQueue is a priority queue that returns a Handle for every
+// element offered to it. The handle can later be used to change the priority
+// of the element or to remove it in O(log n) time, which is what algorithms
+// such as Dijkstra's shortest path need to decrease keys.
+type IndexedPriorityQueue[T any] interface {
+	base.Collection[T]
+
+	// Offer adds an element to the queue and returns a handle to it.
+	Offer(element T) *Handle[T]
+
+	// Poll removes and returns the smallest element of the queue. Returns nil
+	// and false if the queue is empty.
+	Poll() (*T, bool)
+
+	// Peek returns a copy of the smallest element of the queue without
+	// removing it, so modifying it does not affect the order of the queue. Use
+	// Update to change an element. Returns nil and false if the queue is
+	// empty.
+	Peek() (*T, bool)
+
+	// PeekHandle returns the handle of the smallest element of the queue
+	// without removing it. Returns nil and false if the queue is empty.
+	PeekHandle() (*Handle[T], bool)
+
+	// Update replaces the element referred to by handle and restores the heap
+	// order. Returns false if the handle does not belong to the queue, for
+	// example because its element has already been polled or removed.
+	Update(handle *Handle[T], element T) bool
+
+	// Remove removes the element referred to by handle. Returns false if the
+	// handle does not belong to the queue.
+	Remove(handle *Handle[T]) bool
+}
+
+// snapshotIterator iterates over a copy of the elements of a heap taken when
+// the iterator is created, because removing an element reorders the heap.
+// Remove removes the last returned element through the remove function.
+type snapshotIterator[T any] struct {
+	values []T
+	cursor int
+	last   int
+	remove func(element T) bool
+}
+
+func newSnapshotIterator[T any](values []T, remove func(element T) bool) *snapshotIterator[T] {
+	return &snapshotIterator[T]{values: values, last: -1, remove: remove}
+}
+
+func (it *snapshotIterator[T]) HasNext() bool {
+	return it.cursor < len(it.values)
+}
+
+func (it *snapshotIterator[T]) Next() (*T, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	it.last = it.cursor
+	it.cursor++
+	return &it.values[it.last], true
+}
+
+func (it *snapshotIterator[T]) Remove() bool {
+	if it.last < 0 {
+		return false
+	}
+	removed := it.remove(it.values[it.last])
+	it.last = -1
+	return removed
+}
+
+func format[T any](name string, values []T) string {
+	str := name + "(["
+	for i, v := range values {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", v)
+	}
+	return str + "])"
+}
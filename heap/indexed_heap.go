@@ -0,0 +1,294 @@
+package heap
+
+import (
+	"cmp"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// Handle refers to an element offered to an IndexedPriorityQueue. It remains
+// valid until the element is polled or removed from the queue.
+type Handle[T any] struct {
+	value T
+	index int
+	heap  *indexedHeap[T]
+}
+
+// Value returns the element the handle refers to. After the element has been
+// polled or removed, Value returns the last element it held.
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// indexedHeap is an IndexedPriorityQueue backed by a binary heap of handles.
+// Every handle records its index in the heap so that it can be found in O(1)
+// time.
+type indexedHeap[T any] struct {
+	handles []*Handle[T]
+	compare func(a, b T) int
+	equal   func(a, b T) bool
+}
+
+// NewIndexed returns an empty IndexedPriorityQueue that orders its elements
+// using their natural ordering and compares them using the == operator.
+func NewIndexed[T cmp.Ordered]() IndexedPriorityQueue[T] {
+	return NewComparableIndexedFunc(cmp.Compare[T])
+}
+
+// NewIndexedFunc returns an empty IndexedPriorityQueue that orders its
+// elements using the given compare function, which returns a negative number
+// when a < b, a positive number when a > b and zero when a == b, and compares
+// them using the given equal function. If equal is nil, reflect.DeepEqual is
+// used.
+func NewIndexedFunc[T any](compare func(a, b T) int, equal func(a, b T) bool) IndexedPriorityQueue[T] {
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	return &indexedHeap[T]{compare: compare, equal: equal}
+}
+
+// NewComparableIndexedFunc returns an empty IndexedPriorityQueue that orders
+// its elements using the given compare function and compares them using the
+// == operator.
+func NewComparableIndexedFunc[T comparable](compare func(a, b T) int) IndexedPriorityQueue[T] {
+	return NewIndexedFunc(compare, base.Equal[T])
+}
+
+func (h *indexedHeap[T]) Size() int {
+	return len(h.handles)
+}
+
+func (h *indexedHeap[T]) IsEmpty() bool {
+	return len(h.handles) == 0
+}
+
+func (h *indexedHeap[T]) Contains(element T) bool {
+	for _, handle := range h.handles {
+		if h.equal(handle.value, element) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *indexedHeap[T]) Values() []T {
+	var values []T
+	for _, handle := range h.handles {
+		values = append(values, handle.value)
+	}
+	return values
+}
+
+func (h *indexedHeap[T]) Clear() {
+	for _, handle := range h.handles {
+		handle.detach()
+	}
+	clear(h.handles)
+	h.handles = h.handles[:0]
+}
+
+func (h *indexedHeap[T]) String() string {
+	return format("IndexedPriorityQueue", h.Values())
+}
+
+func (h *indexedHeap[T]) Iterator() base.Iterator[T] {
+	handles := append([]*Handle[T](nil), h.handles...)
+	return &indexedHeapIterator[T]{heap: h, handles: handles, last: -1}
+}
+
+func (h *indexedHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, handle := range h.handles {
+			if !yield(handle.value) {
+				return
+			}
+		}
+	}
+}
+
+func (h *indexedHeap[T]) Offer(element T) *Handle[T] {
+	handle := &Handle[T]{value: element, index: len(h.handles), heap: h}
+	h.handles = append(h.handles, handle)
+	h.up(handle.index)
+	return handle
+}
+
+func (h *indexedHeap[T]) Poll() (*T, bool) {
+	if len(h.handles) == 0 {
+		return nil, false
+	}
+	removed := h.removeAt(0).value
+	return &removed, true
+}
+
+func (h *indexedHeap[T]) Peek() (*T, bool) {
+	if len(h.handles) == 0 {
+		return nil, false
+	}
+	top := h.handles[0].value
+	return &top, true
+}
+
+func (h *indexedHeap[T]) PeekHandle() (*Handle[T], bool) {
+	if len(h.handles) == 0 {
+		return nil, false
+	}
+	return h.handles[0], true
+}
+
+func (h *indexedHeap[T]) Update(handle *Handle[T], element T) bool {
+	if !h.owns(handle) {
+		return false
+	}
+	handle.value = element
+	h.down(handle.index)
+	h.up(handle.index)
+	return true
+}
+
+func (h *indexedHeap[T]) Remove(handle *Handle[T]) bool {
+	if !h.owns(handle) {
+		return false
+	}
+	h.removeAt(handle.index)
+	return true
+}
+
+func (h *indexedHeap[T]) owns(handle *Handle[T]) bool {
+	return handle != nil && handle.heap == h
+}
+
+// removeAt removes the handle at index i by moving the last handle into its
+// place and restoring the heap order around it.
+func (h *indexedHeap[T]) removeAt(i int) *Handle[T] {
+	last := len(h.handles) - 1
+	removed := h.handles[i]
+	h.swap(i, last)
+	h.handles[last] = nil
+	h.handles = h.handles[:last]
+	if i < last {
+		h.down(i)
+		h.up(i)
+	}
+	removed.detach()
+	return removed
+}
+
+func (h *indexedHeap[T]) swap(i, j int) {
+	h.handles[i], h.handles[j] = h.handles[j], h.handles[i]
+	h.handles[i].index = i
+	h.handles[j].index = j
+}
+
+func (h *indexedHeap[T]) less(i, j int) bool {
+	return h.compare(h.handles[i].value, h.handles[j].value) < 0
+}
+
+func (h *indexedHeap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(i, parent) {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+func (h *indexedHeap[T]) down(i int) {
+	n := len(h.handles)
+	for {
+		smallest := 2*i + 1
+		if smallest >= n {
+			return
+		}
+		if right := smallest + 1; right < n && h.less(right, smallest) {
+			smallest = right
+		}
+		if !h.less(smallest, i) {
+			return
+		}
+		h.swap(i, smallest)
+		i = smallest
+	}
+}
+
+// detach marks the handle as no longer belonging to any queue.
+func (h *Handle[T]) detach() {
+	h.index = -1
+	h.heap = nil
+}
+
+type indexedHeapIterator[T any] struct {
+	heap    *indexedHeap[T]
+	handles []*Handle[T]
+	cursor  int
+	last    int
+}
+
+func (it *indexedHeapIterator[T]) HasNext() bool {
+	return it.cursor < len(it.handles)
+}
+
+func (it *indexedHeapIterator[T]) Next() (*T, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	it.last = it.cursor
+	it.cursor++
+	return &it.handles[it.last].value, true
+}
+
+func (it *indexedHeapIterator[T]) Remove() bool {
+	if it.last < 0 {
+		return false
+	}
+	removed := it.heap.Remove(it.handles[it.last])
+	it.last = -1
+	return removed
+}
+
+func (h *indexedHeap[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(h.Values())
+}
+
+func (h *indexedHeap[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	h.replace(values)
+	return nil
+}
+
+func (h *indexedHeap[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(h.Values())
+}
+
+func (h *indexedHeap[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	h.replace(values)
+	return nil
+}
+
+func (h *indexedHeap[T]) GobEncode() ([]byte, error) {
+	return h.MarshalBinary()
+}
+
+func (h *indexedHeap[T]) GobDecode(data []byte) error {
+	return h.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the queue with the decoded values. Handles
+// obtained before decoding no longer belong to the queue.
+func (h *indexedHeap[T]) replace(values []T) {
+	h.Clear()
+	for _, v := range values {
+		h.Offer(v)
+	}
+}
@@ -0,0 +1,147 @@
+package heap
+
+import (
+	"bytes"
+	"encoding/gob"
+	"slices"
+	"testing"
+
+	"github.com/elias8/go-gather/compare"
+)
+
+func TestIndexedPriorityQueue_Update(t *testing.T) {
+	q := NewIndexed[int]()
+	q.Offer(5)
+	h := q.Offer(7)
+	q.Offer(3)
+
+	if !q.Update(h, 1) {
+		t.Fatalf("Expected Update to succeed")
+	}
+	if v, _ := q.Peek(); *v != 1 {
+		t.Fatalf("Expected decreased key 1 to be at the head, but got %v", *v)
+	}
+	if !q.Update(h, 9) {
+		t.Fatalf("Expected Update to succeed")
+	}
+
+	expected := []int{3, 5, 9}
+	if values := drain[int](q); !slices.Equal(values, expected) {
+		t.Fatalf("Expected elements to be polled in order %v, but got %v", expected, values)
+	}
+	if h.Value() != 9 {
+		t.Fatalf("Expected polled handle to keep its value 9, but got %v", h.Value())
+	}
+	if q.Update(h, 0) {
+		t.Fatalf("Expected Update to fail for a polled handle")
+	}
+}
+
+func TestIndexedPriorityQueue_Remove(t *testing.T) {
+	q := NewIndexed[int]()
+	var handles []*Handle[int]
+	for _, v := range []int{4, 8, 1, 6, 2} {
+		handles = append(handles, q.Offer(v))
+	}
+
+	if !q.Remove(handles[1]) || !q.Remove(handles[2]) {
+		t.Fatalf("Expected Remove to succeed")
+	}
+	if q.Remove(handles[1]) {
+		t.Fatalf("Expected Remove to fail for an already removed handle")
+	}
+	if q.Remove(NewIndexed[int]().Offer(4)) {
+		t.Fatalf("Expected Remove to fail for a handle of another queue")
+	}
+
+	expected := []int{2, 4, 6}
+	if values := drain[int](q); !slices.Equal(values, expected) {
+		t.Fatalf("Expected elements to be polled in order %v, but got %v", expected, values)
+	}
+}
+
+func TestIndexedPriorityQueue_Clear(t *testing.T) {
+	q := NewIndexed[int]()
+	h := q.Offer(1)
+	q.Clear()
+
+	if !q.IsEmpty() {
+		t.Fatalf("Expected queue to be empty after clearing")
+	}
+	if q.Update(h, 2) {
+		t.Fatalf("Expected Update to fail for a handle of a cleared queue")
+	}
+}
+
+func TestIndexedPriorityQueue_Dijkstra(t *testing.T) {
+	type edge struct{ to, weight int }
+	type entry struct{ node, distance int }
+	graph := [][]edge{
+		0: {{1, 4}, {2, 1}},
+		1: {{3, 1}},
+		2: {{1, 2}, {3, 5}},
+		3: {},
+	}
+
+	q := NewComparableIndexedFunc(compare.Comparing(func(e entry) int { return e.distance }))
+	handles := make([]*Handle[entry], len(graph))
+	distances := []int{0, -1, -1, -1}
+	handles[0] = q.Offer(entry{0, 0})
+	for !q.IsEmpty() {
+		current, _ := q.Poll()
+		distances[current.node] = current.distance
+		for _, e := range graph[current.node] {
+			distance := current.distance + e.weight
+			switch h := handles[e.to]; {
+			case h == nil:
+				handles[e.to] = q.Offer(entry{e.to, distance})
+			case distance < h.Value().distance:
+				q.Update(h, entry{e.to, distance})
+			}
+		}
+	}
+
+	expected := []int{0, 3, 1, 4}
+	if !slices.Equal(distances, expected) {
+		t.Fatalf("Expected shortest distances %v, but got %v", expected, distances)
+	}
+}
+
+func TestIndexedPriorityQueue_Iterator(t *testing.T) {
+	q := NewIndexed[int]()
+	for _, v := range []int{5, 2, 4, 1} {
+		q.Offer(v)
+	}
+
+	it := q.Iterator()
+	for it.HasNext() {
+		if v, _ := it.Next(); *v < 3 {
+			it.Remove()
+		}
+	}
+
+	expected := []int{4, 5}
+	if values := drain[int](q); !slices.Equal(values, expected) {
+		t.Fatalf("Expected %v after removing elements below 3, but got %v", expected, values)
+	}
+}
+
+func TestIndexedPriorityQueue_Gob(t *testing.T) {
+	q := NewIndexed[int]()
+	q.Offer(2)
+	q.Offer(1)
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(q); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	decoded := NewIndexed[int]()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	expected := []int{1, 2}
+	if values := drain[int](decoded); !slices.Equal(values, expected) {
+		t.Fatalf("Expected decoded queue to poll %v, but got %v", expected, values)
+	}
+}
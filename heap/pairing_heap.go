@@ -0,0 +1,286 @@
+package heap
+
+import (
+	"cmp"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// pairingNode is a node of a pairing heap. Its children form a list starting
+// at child and linked through sibling. prev points to the previous sibling, or
+// to the parent for the first child.
+type pairingNode[T any] struct {
+	value   T
+	child   *pairingNode[T]
+	sibling *pairingNode[T]
+	prev    *pairingNode[T]
+}
+
+// pairingHeap is a MergeablePriorityQueue backed by a pairing heap. Offer and
+// Peek take O(1) time and Poll takes amortized O(log n) time. natural records
+// that compare is the natural ordering, which lets Merge meld another
+// naturally ordered heap in O(1) time instead of re-offering its elements.
+type pairingHeap[T any] struct {
+	root    *pairingNode[T]
+	size    int
+	compare func(a, b T) int
+	equal   func(a, b T) bool
+	natural bool
+}
+
+// NewPairing returns an empty MergeablePriorityQueue backed by a pairing heap
+// that orders its elements using their natural ordering and compares them
+// using the == operator.
+func NewPairing[T cmp.Ordered]() MergeablePriorityQueue[T] {
+	return &pairingHeap[T]{compare: cmp.Compare[T], equal: base.Equal[T], natural: true}
+}
+
+// NewPairingFunc returns an empty MergeablePriorityQueue backed by a pairing
+// heap that orders its elements using the given compare function, which
+// returns a negative number when a < b, a positive number when a > b and zero
+// when a == b, and compares them using the given equal function. If equal is
+// nil, reflect.DeepEqual is used.
+func NewPairingFunc[T any](compare func(a, b T) int, equal func(a, b T) bool) MergeablePriorityQueue[T] {
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	return &pairingHeap[T]{compare: compare, equal: equal}
+}
+
+// NewComparablePairingFunc returns an empty MergeablePriorityQueue backed by a
+// pairing heap that orders its elements using the given compare function and
+// compares them using the == operator.
+func NewComparablePairingFunc[T comparable](compare func(a, b T) int) MergeablePriorityQueue[T] {
+	return NewPairingFunc(compare, base.Equal[T])
+}
+
+func (h *pairingHeap[T]) Size() int {
+	return h.size
+}
+
+func (h *pairingHeap[T]) IsEmpty() bool {
+	return h.size == 0
+}
+
+func (h *pairingHeap[T]) Contains(element T) bool {
+	return h.find(element) != nil
+}
+
+func (h *pairingHeap[T]) Values() []T {
+	var values []T
+	for v := range h.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (h *pairingHeap[T]) Clear() {
+	h.root = nil
+	h.size = 0
+}
+
+func (h *pairingHeap[T]) String() string {
+	return format("PairingHeap", h.Values())
+}
+
+func (h *pairingHeap[T]) Iterator() base.Iterator[T] {
+	return newSnapshotIterator(h.Values(), h.remove)
+}
+
+// All yields the elements of the heap in pre-order, which puts the smallest
+// element first.
+func (h *pairingHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := range h.nodes() {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+func (h *pairingHeap[T]) Offer(element T) {
+	h.root = h.meld(h.root, &pairingNode[T]{value: element})
+	h.size++
+}
+
+func (h *pairingHeap[T]) Poll() (*T, bool) {
+	if h.root == nil {
+		return nil, false
+	}
+	removed := h.root.value
+	h.root = h.mergePairs(h.root.child)
+	h.size--
+	return &removed, true
+}
+
+func (h *pairingHeap[T]) Peek() (*T, bool) {
+	if h.root == nil {
+		return nil, false
+	}
+	top := h.root.value
+	return &top, true
+}
+
+func (h *pairingHeap[T]) Merge(other MergeablePriorityQueue[T]) {
+	if other == nil || other == MergeablePriorityQueue[T](h) {
+		return
+	}
+	// The nodes of other are ordered by its own compare function, so they can
+	// only be melded as they are if both heaps are known to agree on it.
+	if o, ok := other.(*pairingHeap[T]); ok && h.natural && o.natural {
+		h.root = h.meld(h.root, o.root)
+		h.size += o.size
+		o.Clear()
+		return
+	}
+	for _, v := range other.Values() {
+		h.Offer(v)
+	}
+	other.Clear()
+}
+
+// remove removes the first node, in pre-order, whose value is equal to
+// element.
+func (h *pairingHeap[T]) remove(element T) bool {
+	n := h.find(element)
+	if n == nil {
+		return false
+	}
+	if n == h.root {
+		h.Poll()
+		return true
+	}
+	if n.prev.child == n {
+		n.prev.child = n.sibling
+	} else {
+		n.prev.sibling = n.sibling
+	}
+	if n.sibling != nil {
+		n.sibling.prev = n.prev
+	}
+	n.prev, n.sibling = nil, nil
+	h.root = h.meld(h.root, h.mergePairs(n.child))
+	h.size--
+	return true
+}
+
+func (h *pairingHeap[T]) find(element T) *pairingNode[T] {
+	for n := range h.nodes() {
+		if h.equal(n.value, element) {
+			return n
+		}
+	}
+	return nil
+}
+
+// nodes yields the nodes of the heap in pre-order.
+func (h *pairingHeap[T]) nodes() iter.Seq[*pairingNode[T]] {
+	return func(yield func(*pairingNode[T]) bool) {
+		if h.root == nil {
+			return
+		}
+		stack := []*pairingNode[T]{h.root}
+		for len(stack) > 0 {
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n) {
+				return
+			}
+			if n.sibling != nil {
+				stack = append(stack, n.sibling)
+			}
+			if n.child != nil {
+				stack = append(stack, n.child)
+			}
+		}
+	}
+}
+
+// meld links two detached heaps by making the root with the larger value the
+// first child of the other, and returns the resulting root.
+func (h *pairingHeap[T]) meld(a, b *pairingNode[T]) *pairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.compare(b.value, a.value) < 0 {
+		a, b = b, a
+	}
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	return a
+}
+
+// mergePairs melds a list of sibling heaps into one using the standard two
+// pass strategy: siblings are first melded in pairs from left to right, and
+// the pairs are then melded from right to left.
+func (h *pairingHeap[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+	var pairs []*pairingNode[T]
+	for first != nil {
+		a, b := first, first.sibling
+		a.prev, a.sibling = nil, nil
+		if b == nil {
+			pairs = append(pairs, a)
+			break
+		}
+		first = b.sibling
+		b.prev, b.sibling = nil, nil
+		pairs = append(pairs, h.meld(a, b))
+	}
+	var root *pairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.meld(pairs[i], root)
+	}
+	return root
+}
+
+func (h *pairingHeap[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(h.Values())
+}
+
+func (h *pairingHeap[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	h.replace(values)
+	return nil
+}
+
+func (h *pairingHeap[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(h.Values())
+}
+
+func (h *pairingHeap[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	h.replace(values)
+	return nil
+}
+
+func (h *pairingHeap[T]) GobEncode() ([]byte, error) {
+	return h.MarshalBinary()
+}
+
+func (h *pairingHeap[T]) GobDecode(data []byte) error {
+	return h.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the heap with the decoded values.
+func (h *pairingHeap[T]) replace(values []T) {
+	h.Clear()
+	for _, v := range values {
+		h.Offer(v)
+	}
+}
@@ -0,0 +1,117 @@
+package heap
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/elias8/go-gather/compare"
+)
+
+func TestPairingHeap_Poll(t *testing.T) {
+	q := NewPairing[int]()
+	r := rand.New(rand.NewSource(1))
+	var expected []int
+	for range 200 {
+		v := r.Intn(50)
+		expected = append(expected, v)
+		q.Offer(v)
+	}
+	slices.Sort(expected)
+
+	if v, _ := q.Peek(); *v != expected[0] {
+		t.Fatalf("Expected Peek to return %v, but got %v", expected[0], *v)
+	}
+	if values := drain[int](q); !slices.Equal(values, expected) {
+		t.Fatalf("Expected elements to be polled in order %v, but got %v", expected, values)
+	}
+	if !q.IsEmpty() {
+		t.Fatalf("Expected heap to be empty")
+	}
+}
+
+func TestPairingHeap_Merge(t *testing.T) {
+	q := NewPairing[int]()
+	q.Offer(5)
+	q.Offer(1)
+	other := NewPairing[int]()
+	other.Offer(4)
+	other.Offer(0)
+	q.Merge(other)
+	q.Merge(NewPairing[int]())
+
+	if !other.IsEmpty() {
+		t.Fatalf("Expected merged heap to be empty")
+	}
+	if q.Size() != 4 {
+		t.Fatalf("Expected heap to have 4 elements, but got %v", q.Size())
+	}
+	expected := []int{0, 1, 4, 5}
+	if values := drain[int](q); !slices.Equal(values, expected) {
+		t.Fatalf("Expected elements to be polled in order %v, but got %v", expected, values)
+	}
+}
+
+func TestPairingHeap_MergeDifferentOrder(t *testing.T) {
+	q := NewPairing[int]()
+	q.Offer(5)
+	q.Offer(1)
+	other := NewComparablePairingFunc(compare.Reverse(compare.Natural[int]()))
+	other.Offer(0)
+	other.Offer(4)
+	q.Merge(other)
+
+	if !other.IsEmpty() {
+		t.Fatalf("Expected merged heap to be empty")
+	}
+	expected := []int{0, 1, 4, 5}
+	if values := drain[int](q); !slices.Equal(values, expected) {
+		t.Fatalf("Expected elements to be polled in order %v, but got %v", expected, values)
+	}
+}
+
+func TestPairingHeap_MergeSelf(t *testing.T) {
+	q := NewPairing[int]()
+	q.Offer(1)
+	q.Merge(q)
+
+	if q.Size() != 1 {
+		t.Fatalf("Expected merging a heap into itself to be a no-op, but got %v", q)
+	}
+}
+
+func TestPairingHeap_Iterator(t *testing.T) {
+	q := NewPairing[int]()
+	for i := range 20 {
+		q.Offer(i)
+	}
+	q.Poll()
+
+	it := q.Iterator()
+	for it.HasNext() {
+		if v, _ := it.Next(); *v%3 == 0 {
+			it.Remove()
+		}
+	}
+
+	expected := []int{1, 2, 4, 5, 7, 8, 10, 11, 13, 14, 16, 17, 19}
+	if q.Size() != len(expected) {
+		t.Fatalf("Expected heap to have %v elements, but got %v", len(expected), q.Size())
+	}
+	if values := drain[int](q); !slices.Equal(values, expected) {
+		t.Fatalf("Expected %v after removing multiples of 3, but got %v", expected, values)
+	}
+}
+
+func TestPairingHeap_String(t *testing.T) {
+	q := NewPairing[int]()
+	q.Offer(2)
+	q.Offer(1)
+
+	if q.String() != "PairingHeap([1, 2])" {
+		t.Fatalf("Expected PairingHeap([1, 2]), but got %v", q.String())
+	}
+	if !q.Contains(2) || q.Contains(3) {
+		t.Fatalf("Expected heap to contain 2 but not 3")
+	}
+}
@@ -0,0 +1,42 @@
+package immutable
+
+import (
+	"fmt"
+	"iter"
+)
+
+// readOnlyIterator iterates over the elements of a persistent collection.
+// Since persistent collections cannot be modified, Remove is not supported.
+type readOnlyIterator[T any] struct {
+	values []T
+	cursor int
+}
+
+func (it *readOnlyIterator[T]) HasNext() bool {
+	return it.cursor < len(it.values)
+}
+
+func (it *readOnlyIterator[T]) Next() (*T, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	it.cursor++
+	return &it.values[it.cursor-1], true
+}
+
+func (it *readOnlyIterator[T]) Remove() bool {
+	return false
+}
+
+func format[T any](name string, values iter.Seq[T]) string {
+	str := name + "(["
+	first := true
+	for v := range values {
+		if !first {
+			str += ", "
+		}
+		first = false
+		str += fmt.Sprintf("%v", v)
+	}
+	return str + "])"
+}
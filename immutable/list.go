@@ -0,0 +1,391 @@
+// Package immutable provides persistent collections. They are never modified
+// in place: every method that would change a collection instead returns a new
+// version, which shares most of its structure with the old one. Old versions
+// therefore stay valid, and collections can be shared between goroutines
+// without copying or locking.
+package immutable
+
+import (
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// List is a persistent list. It provides the read-only methods of list.List,
+// and methods that return new versions of the list instead of modifying it.
+type List[T any] interface {
+	// Contains returns true if the list contains the specified element.
+	Contains(element T) bool
+
+	// IsEmpty returns true if the list contains no elements.
+	IsEmpty() bool
+
+	// Size returns the number of elements in the list.
+	Size() int
+
+	// Values returns a slice containing the elements of the list, in order.
+	Values() []T
+
+	// String returns a string representation of the list.
+	String() string
+
+	// Iterator returns an iterator over the elements of the list. The
+	// iterator does not support Remove.
+	Iterator() base.Iterator[T]
+
+	// All returns an iterator over the elements of the list, in order.
+	All() iter.Seq[T]
+
+	// Backward returns an iterator over the index-element pairs of the list,
+	// from the last element to the first.
+	Backward() iter.Seq2[int, T]
+
+	// Get returns the element at the specified position in the list. If the
+	// index is out of range (index < 0 || index >= Size()), returns nil and
+	// false.
+	//
+	// The operation is performed in O(log32 n) time.
+	Get(index int) (*T, bool)
+
+	// IndexOf returns the index of the first occurrence of the specified
+	// element in the list. If the list does not contain the element, returns
+	// -1 and false.
+	IndexOf(element T) (int, bool)
+
+	// LastIndexOf returns the index of the last occurrence of the specified
+	// element in the list. If the list does not contain the element, returns
+	// -1 and false.
+	LastIndexOf(element T) (int, bool)
+
+	// With returns a new list with the specified elements appended to the
+	// end, in order.
+	//
+	// The operation is performed in O(log32 n) time per element.
+	With(elements ...T) List[T]
+
+	// Set returns a new list in which the element at the specified position
+	// is replaced with the specified element. Returns the list itself and
+	// false if the index is out of range (index < 0 || index >= Size()).
+	//
+	// The operation is performed in O(log32 n) time.
+	Set(index int, element T) (List[T], bool)
+
+	// Without returns a new list without the first occurrence of the
+	// specified element. Returns the list itself if it does not contain the
+	// element.
+	//
+	// The operation is performed in O(n) time.
+	Without(element T) List[T]
+
+	// WithoutAt returns a new list without the element at the specified
+	// position. Returns the list itself and false if the index is out of range
+	// (index < 0 || index >= Size()).
+	//
+	// Removing the last element is performed in O(log32 n) time, and
+	// removing any other element in O(n) time.
+	WithoutAt(index int) (List[T], bool)
+}
+
+const (
+	vectorBits  = 5
+	vectorWidth = 1 << vectorBits
+	vectorMask  = vectorWidth - 1
+)
+
+// vectorNode is a node of a vector trie. Leaves hold up to vectorWidth values
+// and branches up to vectorWidth children. Nodes are never modified once they
+// are reachable from a vector.
+type vectorNode[T any] struct {
+	children []*vectorNode[T]
+	values   []T
+}
+
+// vector is a List backed by a persistent bit-partitioned vector trie with a
+// branching factor of 32. The last, possibly partial, leaf is kept outside of
+// the trie in tail, so that appending only copies the tail most of the time.
+type vector[T any] struct {
+	root  *vectorNode[T]
+	tail  []T
+	size  int
+	shift int
+	equal func(a, b T) bool
+}
+
+// NewList returns an empty List. Elements are compared using
+// reflect.DeepEqual.
+func NewList[T any]() List[T] {
+	return NewListFunc(base.DeepEqual[T])
+}
+
+// NewListFunc returns an empty List that compares elements using the given
+// equal function. If equal is nil, reflect.DeepEqual is used.
+func NewListFunc[T any](equal func(a, b T) bool) List[T] {
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	return &vector[T]{root: &vectorNode[T]{}, shift: vectorBits, equal: equal}
+}
+
+// NewComparableList returns an empty List that compares elements using the ==
+// operator.
+func NewComparableList[T comparable]() List[T] {
+	return NewListFunc(base.Equal[T])
+}
+
+func (v *vector[T]) Contains(element T) bool {
+	_, found := v.IndexOf(element)
+	return found
+}
+
+func (v *vector[T]) IsEmpty() bool {
+	return v.size == 0
+}
+
+func (v *vector[T]) Size() int {
+	return v.size
+}
+
+func (v *vector[T]) Values() []T {
+	var values []T
+	for e := range v.All() {
+		values = append(values, e)
+	}
+	return values
+}
+
+func (v *vector[T]) String() string {
+	return format("List", v.All())
+}
+
+func (v *vector[T]) Iterator() base.Iterator[T] {
+	return &readOnlyIterator[T]{values: v.Values()}
+}
+
+func (v *vector[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < v.size; i += vectorWidth {
+			for _, e := range v.leaf(i) {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (v *vector[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := v.size - 1; i >= 0; i-- {
+			if !yield(i, v.leaf(i)[i&vectorMask]) {
+				return
+			}
+		}
+	}
+}
+
+func (v *vector[T]) Get(index int) (*T, bool) {
+	if index < 0 || index >= v.size {
+		return nil, false
+	}
+	value := v.leaf(index)[index&vectorMask]
+	return &value, true
+}
+
+func (v *vector[T]) IndexOf(element T) (int, bool) {
+	i := 0
+	for e := range v.All() {
+		if v.equal(e, element) {
+			return i, true
+		}
+		i++
+	}
+	return -1, false
+}
+
+func (v *vector[T]) LastIndexOf(element T) (int, bool) {
+	for i, e := range v.Backward() {
+		if v.equal(e, element) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func (v *vector[T]) With(elements ...T) List[T] {
+	result := v
+	for _, e := range elements {
+		result = result.push(e)
+	}
+	return result
+}
+
+func (v *vector[T]) Set(index int, element T) (List[T], bool) {
+	if index < 0 || index >= v.size {
+		return v, false
+	}
+	result := *v
+	if index >= v.tailOffset() {
+		result.tail = append([]T(nil), v.tail...)
+		result.tail[index&vectorMask] = element
+	} else {
+		result.root = v.set(v.shift, v.root, index, element)
+	}
+	return &result, true
+}
+
+func (v *vector[T]) Without(element T) List[T] {
+	index, found := v.IndexOf(element)
+	if !found {
+		return v
+	}
+	result, _ := v.WithoutAt(index)
+	return result
+}
+
+func (v *vector[T]) WithoutAt(index int) (List[T], bool) {
+	if index < 0 || index >= v.size {
+		return v, false
+	}
+	if index == v.size-1 {
+		return v.pop(), true
+	}
+	values := v.Values()
+	result := &vector[T]{root: &vectorNode[T]{}, shift: vectorBits, equal: v.equal}
+	return result.With(append(values[:index], values[index+1:]...)...), true
+}
+
+// tailOffset returns the index of the first element stored in the tail.
+func (v *vector[T]) tailOffset() int {
+	if v.size < vectorWidth {
+		return 0
+	}
+	return ((v.size - 1) >> vectorBits) << vectorBits
+}
+
+// leaf returns the values of the leaf, or the tail, that holds the element at
+// the given index.
+func (v *vector[T]) leaf(index int) []T {
+	if index >= v.tailOffset() {
+		return v.tail
+	}
+	node := v.root
+	for level := v.shift; level > 0; level -= vectorBits {
+		node = node.children[(index>>level)&vectorMask]
+	}
+	return node.values
+}
+
+// push returns a new vector with element appended to the end. When the tail is
+// full, it is moved into the trie first, adding a new root level if the trie
+// is full as well.
+func (v *vector[T]) push(element T) *vector[T] {
+	result := *v
+	result.size++
+	if v.size-v.tailOffset() < vectorWidth {
+		result.tail = append(v.tail[:len(v.tail):len(v.tail)], element)
+		return &result
+	}
+	leaf := &vectorNode[T]{values: v.tail}
+	if v.size>>vectorBits > 1<<v.shift {
+		result.root = &vectorNode[T]{children: []*vectorNode[T]{v.root, newPath(v.shift, leaf)}}
+		result.shift += vectorBits
+	} else {
+		result.root = v.pushLeaf(v.shift, v.root, leaf)
+	}
+	result.tail = []T{element}
+	return &result
+}
+
+// pushLeaf returns a copy of the path from node to the position of the next
+// leaf, with leaf inserted at the end of that path.
+func (v *vector[T]) pushLeaf(level int, node, leaf *vectorNode[T]) *vectorNode[T] {
+	index := ((v.size - 1) >> level) & vectorMask
+	result := &vectorNode[T]{children: append([]*vectorNode[T](nil), node.children...)}
+	child := leaf
+	if level > vectorBits {
+		if index < len(node.children) {
+			child = v.pushLeaf(level-vectorBits, node.children[index], leaf)
+		} else {
+			child = newPath(level-vectorBits, leaf)
+		}
+	}
+	if index < len(result.children) {
+		result.children[index] = child
+	} else {
+		result.children = append(result.children, child)
+	}
+	return result
+}
+
+// pop returns a new vector without its last element. When the tail holds only
+// that element, the rightmost leaf of the trie becomes the new tail, and the
+// root level is removed if it is left with a single child.
+func (v *vector[T]) pop() *vector[T] {
+	result := *v
+	result.size--
+	if len(v.tail) > 1 {
+		result.tail = v.tail[: len(v.tail)-1 : len(v.tail)-1]
+		return &result
+	}
+	if v.size == 1 {
+		result.tail = nil
+		return &result
+	}
+	result.tail = v.leaf(v.size - 2)
+	result.root = v.popLeaf(v.shift, v.root)
+	if result.root == nil {
+		result.root = &vectorNode[T]{}
+	}
+	if result.shift > vectorBits && len(result.root.children) == 1 {
+		result.root = result.root.children[0]
+		result.shift -= vectorBits
+	}
+	return &result
+}
+
+// popLeaf returns a copy of the path from node to the rightmost leaf, with the
+// leaf removed. Returns nil if the node is left without children.
+func (v *vector[T]) popLeaf(level int, node *vectorNode[T]) *vectorNode[T] {
+	index := ((v.size - 2) >> level) & vectorMask
+	children := node.children[:index:index]
+	if level > vectorBits {
+		if child := v.popLeaf(level-vectorBits, node.children[index]); child != nil {
+			children = append(children, child)
+		}
+	}
+	if len(children) == 0 {
+		return nil
+	}
+	return &vectorNode[T]{children: children}
+}
+
+// set returns a copy of the path from node to the element at index, with the
+// element replaced.
+func (v *vector[T]) set(level int, node *vectorNode[T], index int, element T) *vectorNode[T] {
+	if level == 0 {
+		values := append([]T(nil), node.values...)
+		values[index&vectorMask] = element
+		return &vectorNode[T]{values: values}
+	}
+	children := append([]*vectorNode[T](nil), node.children...)
+	i := (index >> level) & vectorMask
+	children[i] = v.set(level-vectorBits, node.children[i], index, element)
+	return &vectorNode[T]{children: children}
+}
+
+// newPath returns a chain of branches of the given height ending in leaf.
+func newPath[T any](level int, leaf *vectorNode[T]) *vectorNode[T] {
+	if level == 0 {
+		return leaf
+	}
+	return &vectorNode[T]{children: []*vectorNode[T]{newPath(level-vectorBits, leaf)}}
+}
+
+func (v *vector[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(v.Values())
+}
+
+func (v *vector[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(v.Values())
+}
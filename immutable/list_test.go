@@ -0,0 +1,208 @@
+package immutable
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func rangeOf(n int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = i
+	}
+	return values
+}
+
+func TestNewList(t *testing.T) {
+	l := NewList[int]()
+
+	if !l.IsEmpty() {
+		t.Fatalf("Expected list to be empty")
+	}
+	if _, ok := l.Get(0); ok {
+		t.Fatalf("Expected Get on an empty list to fail")
+	}
+	if l.String() != "List([])" {
+		t.Fatalf("Expected List([]), but got %v", l.String())
+	}
+}
+
+func TestNewListFunc(t *testing.T) {
+	l := NewListFunc(func(a, b float64) bool { return int(a) == int(b) }).With(1.2)
+
+	if !l.Contains(1.8) {
+		t.Fatalf("Expected list to contain 1.8 using the custom equality")
+	}
+}
+
+func TestList_With(t *testing.T) {
+	scenarios := []struct {
+		name string
+		size int
+	}{
+		{name: "tail only", size: 20},
+		{name: "full tail", size: 32},
+		{name: "one level", size: 33},
+		{name: "full first level", size: 32*32 + 32},
+		{name: "two levels", size: 32*32 + 33},
+		{name: "three levels", size: 32*32*32 + 100},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			expected := rangeOf(s.size)
+			l := NewComparableList[int]().With(expected...)
+
+			if l.Size() != s.size {
+				t.Fatalf("Expected list to have %v elements, but got %v", s.size, l.Size())
+			}
+			if values := l.Values(); !slices.Equal(values, expected) {
+				t.Fatalf("Expected list to hold 0 to %v in order", s.size-1)
+			}
+			for i := range expected {
+				if v, ok := l.Get(i); !ok || *v != i {
+					t.Fatalf("Expected Get(%v) to return %v", i, i)
+				}
+			}
+			var backward []int
+			for i, v := range l.Backward() {
+				if i != v {
+					t.Fatalf("Expected Backward to yield index %v with value %v, but got %v", i, i, v)
+				}
+				backward = append(backward, v)
+			}
+			if len(backward) != s.size {
+				t.Fatalf("Expected Backward to yield %v elements, but got %v", s.size, len(backward))
+			}
+		})
+	}
+}
+
+func TestList_Persistence(t *testing.T) {
+	original := NewComparableList[int]().With(rangeOf(100)...)
+	appended := original.With(100)
+	changed, _ := original.Set(40, -1)
+	removed, _ := original.WithoutAt(99)
+
+	if original.Size() != 100 || !slices.Equal(original.Values(), rangeOf(100)) {
+		t.Fatalf("Expected the original list to be unchanged, but got %v", original)
+	}
+	if appended.Size() != 101 || !slices.Equal(appended.Values(), rangeOf(101)) {
+		t.Fatalf("Expected the appended list to hold 0 to 100, but got %v", appended)
+	}
+	if v, _ := changed.Get(40); *v != -1 {
+		t.Fatalf("Expected Set to replace the element at 40 with -1, but got %v", *v)
+	}
+	if removed.Size() != 99 || removed.Contains(99) {
+		t.Fatalf("Expected the last element to be removed, but got %v", removed)
+	}
+
+	forked := removed.With(-5)
+	if v, _ := original.Get(99); *v != 99 {
+		t.Fatalf("Expected appending to a shrunk list to leave the original unchanged, but got %v", *v)
+	}
+	if v, _ := forked.Get(99); *v != -5 {
+		t.Fatalf("Expected the forked list to end with -5, but got %v", *v)
+	}
+}
+
+func TestList_WithoutLast(t *testing.T) {
+	// Removing the last elements one by one moves leaves from the trie to
+	// the tail and removes root levels as the list shrinks.
+	const size = 32*32*32 + 100
+	lists := []List[int]{NewComparableList[int]().With(rangeOf(size)...)}
+	for n := size; n > 0; n-- {
+		l, ok := lists[len(lists)-1].WithoutAt(n - 1)
+		if !ok || l.Size() != n-1 {
+			t.Fatalf("Expected WithoutAt(%v) to leave %v elements", n-1, n-1)
+		}
+		if n-1 > 0 {
+			if v, _ := l.Get(n - 2); *v != n-2 {
+				t.Fatalf("Expected the list to end with %v, but got %v", n-2, *v)
+			}
+		}
+		lists = append(lists, l)
+	}
+
+	for _, n := range []int{0, 1, 32, 33, 32*32 + 32, 32*32 + 33, 5000} {
+		l := lists[size-n]
+		if values := l.Values(); !slices.Equal(values, rangeOf(n)) {
+			t.Fatalf("Expected the list to hold 0 to %v in order", n-1)
+		}
+		if grown := l.With(-1); grown.Size() != n+1 {
+			t.Fatalf("Expected appending to a shrunk list to work, but got %v elements", grown.Size())
+		} else if v, _ := grown.Get(n); *v != -1 {
+			t.Fatalf("Expected the grown list to end with -1, but got %v", *v)
+		}
+	}
+	if values := lists[0].Values(); !slices.Equal(values, rangeOf(size)) {
+		t.Fatalf("Expected the original list to be unchanged")
+	}
+}
+
+func TestList_Set(t *testing.T) {
+	l := NewComparableList[int]().With(rangeOf(1000)...)
+	for _, i := range []int{0, 31, 32, 500, 991, 999} {
+		changed, ok := l.Set(i, -i-1)
+		if !ok {
+			t.Fatalf("Expected Set(%v) to succeed", i)
+		}
+		if v, _ := changed.Get(i); *v != -i-1 {
+			t.Fatalf("Expected Set(%v) to replace the element, but got %v", i, *v)
+		}
+		if v, _ := l.Get(i); *v != i {
+			t.Fatalf("Expected the original list to keep %v, but got %v", i, *v)
+		}
+	}
+	if same, ok := l.Set(1000, 0); ok || same != l {
+		t.Fatalf("Expected Set to fail for an out of range index")
+	}
+}
+
+func TestList_Without(t *testing.T) {
+	l := NewComparableList[string]().With("a", "b", "c", "b")
+
+	if values := l.Without("b").Values(); !slices.Equal(values, []string{"a", "c", "b"}) {
+		t.Fatalf("Expected [a c b], but got %v", values)
+	}
+	if l.Without("z") != l {
+		t.Fatalf("Expected Without to return the list itself when the element is absent")
+	}
+	if without, _ := l.WithoutAt(0); !slices.Equal(without.Values(), []string{"b", "c", "b"}) {
+		t.Fatalf("Expected [b c b], but got %v", without)
+	}
+	if _, ok := l.WithoutAt(4); ok {
+		t.Fatalf("Expected WithoutAt to fail for an out of range index")
+	}
+	if i, _ := l.LastIndexOf("b"); i != 3 {
+		t.Fatalf("Expected LastIndexOf(b) to return 3, but got %v", i)
+	}
+}
+
+func TestList_Iterator(t *testing.T) {
+	l := NewList[int]().With(1, 2)
+
+	var values []int
+	it := l.Iterator()
+	for it.HasNext() {
+		v, _ := it.Next()
+		values = append(values, *v)
+		if it.Remove() {
+			t.Fatalf("Expected Remove to be unsupported")
+		}
+	}
+	if !slices.Equal(values, []int{1, 2}) {
+		t.Fatalf("Expected [1 2], but got %v", values)
+	}
+}
+
+func TestList_JSON(t *testing.T) {
+	data, err := json.Marshal(NewList[int]().With(1, 2))
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if string(data) != "[1,2]" {
+		t.Fatalf("Expected [1,2], but got %s", data)
+	}
+}
@@ -0,0 +1,177 @@
+package immutable
+
+import (
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// Stack is a persistent LIFO (last in, first out) stack. It provides the
+// read-only methods of stack.Stack, and Push and Pop methods that return new
+// versions of the stack instead of modifying it.
+type Stack[T any] interface {
+	// Contains returns true if the stack contains the specified element.
+	Contains(element T) bool
+
+	// IsEmpty returns true if the stack contains no elements.
+	IsEmpty() bool
+
+	// Size returns the number of elements in the stack.
+	Size() int
+
+	// Values returns a slice containing the elements of the stack, from the
+	// bottom element to the top.
+	Values() []T
+
+	// String returns a string representation of the stack.
+	String() string
+
+	// Iterator returns an iterator over the elements of the stack, from the
+	// bottom element to the top. The iterator does not support Remove.
+	Iterator() base.Iterator[T]
+
+	// All returns an iterator over the elements of the stack, from the bottom
+	// element to the top.
+	All() iter.Seq[T]
+
+	// Backward returns an iterator over the index-element pairs of the stack,
+	// from the top element to the bottom.
+	Backward() iter.Seq2[int, T]
+
+	// Peek returns the top element of the stack. Returns nil and false if the
+	// stack is empty.
+	Peek() (*T, bool)
+
+	// Push returns a new stack with the element added to the top.
+	//
+	// The operation is performed in O(1) time.
+	Push(element T) Stack[T]
+
+	// Pop returns a new stack without the top element. Returns the stack
+	// itself and false if the stack is empty.
+	//
+	// The operation is performed in O(1) time.
+	Pop() (Stack[T], bool)
+}
+
+// cons is a cell of a singly linked list. Cells are shared by every stack
+// that contains them and are never modified.
+type cons[T any] struct {
+	value T
+	next  *cons[T]
+}
+
+// stack is a Stack backed by a singly linked list whose head is the top of
+// the stack.
+type stack[T any] struct {
+	top   *cons[T]
+	size  int
+	equal func(a, b T) bool
+}
+
+// NewStack returns an empty Stack. Elements are compared using
+// reflect.DeepEqual.
+func NewStack[T any]() Stack[T] {
+	return NewStackFunc(base.DeepEqual[T])
+}
+
+// NewStackFunc returns an empty Stack that compares elements using the given
+// equal function. If equal is nil, reflect.DeepEqual is used.
+func NewStackFunc[T any](equal func(a, b T) bool) Stack[T] {
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	return &stack[T]{equal: equal}
+}
+
+// NewComparableStack returns an empty Stack that compares elements using the
+// == operator.
+func NewComparableStack[T comparable]() Stack[T] {
+	return NewStackFunc(base.Equal[T])
+}
+
+func (s *stack[T]) Contains(element T) bool {
+	for c := s.top; c != nil; c = c.next {
+		if s.equal(c.value, element) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *stack[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+func (s *stack[T]) Size() int {
+	return s.size
+}
+
+func (s *stack[T]) Values() []T {
+	if s.size == 0 {
+		return nil
+	}
+	values := make([]T, s.size)
+	for i, v := range s.Backward() {
+		values[i] = v
+	}
+	return values
+}
+
+func (s *stack[T]) String() string {
+	return format("Stack", s.All())
+}
+
+func (s *stack[T]) Iterator() base.Iterator[T] {
+	return &readOnlyIterator[T]{values: s.Values()}
+}
+
+func (s *stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.Values() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *stack[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := s.size - 1
+		for c := s.top; c != nil; c = c.next {
+			if !yield(i, c.value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+func (s *stack[T]) Peek() (*T, bool) {
+	if s.top == nil {
+		return nil, false
+	}
+	value := s.top.value
+	return &value, true
+}
+
+func (s *stack[T]) Push(element T) Stack[T] {
+	return &stack[T]{top: &cons[T]{value: element, next: s.top}, size: s.size + 1, equal: s.equal}
+}
+
+func (s *stack[T]) Pop() (Stack[T], bool) {
+	if s.top == nil {
+		return s, false
+	}
+	return &stack[T]{top: s.top.next, size: s.size - 1, equal: s.equal}, true
+}
+
+func (s *stack[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(s.Values())
+}
+
+func (s *stack[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(s.Values())
+}
@@ -0,0 +1,64 @@
+package immutable
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewStack(t *testing.T) {
+	s := NewStack[int]()
+
+	if !s.IsEmpty() {
+		t.Fatalf("Expected stack to be empty")
+	}
+	if _, ok := s.Peek(); ok {
+		t.Fatalf("Expected Peek on an empty stack to fail")
+	}
+	if popped, ok := s.Pop(); ok || popped != s {
+		t.Fatalf("Expected Pop on an empty stack to fail")
+	}
+}
+
+func TestStack_PushPop(t *testing.T) {
+	empty := NewComparableStack[int]()
+	one := empty.Push(1)
+	two := one.Push(2)
+	other := one.Push(3)
+
+	if empty.Size() != 0 || one.Size() != 1 || two.Size() != 2 {
+		t.Fatalf("Expected older versions to keep their size")
+	}
+	if v, _ := two.Peek(); *v != 2 {
+		t.Fatalf("Expected 2 on top, but got %v", *v)
+	}
+	if v, _ := other.Peek(); *v != 3 {
+		t.Fatalf("Expected 3 on top of the other branch, but got %v", *v)
+	}
+	if popped, _ := two.Pop(); popped.Size() != 1 || !popped.Contains(1) || popped.Contains(2) {
+		t.Fatalf("Expected popping to leave [1], but got %v", popped)
+	}
+	if !two.Contains(2) {
+		t.Fatalf("Expected popping to leave the original stack unchanged")
+	}
+}
+
+func TestStack_Values(t *testing.T) {
+	s := NewStack[int]().Push(1).Push(2).Push(3)
+
+	if values := s.Values(); !slices.Equal(values, []int{1, 2, 3}) {
+		t.Fatalf("Expected [1 2 3], but got %v", values)
+	}
+	if values := slices.Collect(s.All()); !slices.Equal(values, []int{1, 2, 3}) {
+		t.Fatalf("Expected All to yield [1 2 3], but got %v", values)
+	}
+	var backward []int
+	for _, v := range s.Backward() {
+		backward = append(backward, v)
+	}
+	if !slices.Equal(backward, []int{3, 2, 1}) {
+		t.Fatalf("Expected Backward to yield [3 2 1], but got %v", backward)
+	}
+	if s.String() != "Stack([1, 2, 3])" {
+		t.Fatalf("Expected Stack([1, 2, 3]), but got %v", s.String())
+	}
+}
@@ -0,0 +1,47 @@
+// Package encoding serializes the elements of collections. Every collection is
+// encoded as a plain array of its elements, in the order of its Values method,
+// so that it round-trips through any collection of the same element type and
+// can be read by services that are not written in Go.
+package encoding
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON encodes the values as a JSON array. A nil slice is encoded as an
+// empty array rather than null.
+func MarshalJSON[T any](values []T) ([]byte, error) {
+	if values == nil {
+		values = []T{}
+	}
+	return json.Marshal(values)
+}
+
+// UnmarshalJSON decodes a JSON array into a slice of values.
+func UnmarshalJSON[T any](data []byte) ([]T, error) {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// MarshalBinary encodes the values as a gob-encoded slice.
+func MarshalBinary[T any](values []T) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(values); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a gob-encoded slice of values.
+func UnmarshalBinary[T any](data []byte) ([]T, error) {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
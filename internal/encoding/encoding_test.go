@@ -0,0 +1,62 @@
+package encoding
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		values   []int
+		expected string
+	}{
+		{name: "nil slice", values: nil, expected: "[]"},
+		{name: "empty slice", values: []int{}, expected: "[]"},
+		{name: "multiple values", values: []int{1, 2, 3}, expected: "[1,2,3]"},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			data, err := MarshalJSON(s.values)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if string(data) != s.expected {
+				t.Fatalf("Expected %s, got %s", s.expected, data)
+			}
+			values, err := UnmarshalJSON[int](data)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(values) != len(s.values) || !slices.Equal(values, s.values) && len(s.values) > 0 {
+				t.Fatalf("Expected %v, got %v", s.values, values)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON_Invalid(t *testing.T) {
+	if _, err := UnmarshalJSON[int]([]byte(`{"a": 1}`)); err == nil {
+		t.Fatalf("Expected an error when decoding an object")
+	}
+}
+
+func TestBinary(t *testing.T) {
+	for _, values := range [][]string{nil, {}, {"a", "b"}} {
+		data, err := MarshalBinary(values)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		decoded, err := UnmarshalBinary[string](data)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !slices.Equal(decoded, values) {
+			t.Fatalf("Expected %v, got %v", values, decoded)
+		}
+	}
+	if _, err := UnmarshalBinary[string]([]byte("garbage")); err == nil {
+		t.Fatalf("Expected an error when decoding garbage")
+	}
+}
@@ -0,0 +1,204 @@
+// Package hashing computes hash/maphash hashes of collections from the hashes
+// of their elements, and provides Deep, a hash of arbitrary values that is
+// consistent with reflect.DeepEqual.
+package hashing
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"iter"
+	"math"
+	"reflect"
+)
+
+// Ordered returns the hash of values, in order, so that sequences with the
+// same elements in a different order hash differently. Every element is
+// hashed with the given hash function.
+func Ordered[T any](seed maphash.Seed, values iter.Seq[T], hash func(seed maphash.Seed, v T) uint64) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	for v := range values {
+		writeUint64(&h, hash(seed, v))
+	}
+	return h.Sum64()
+}
+
+// Unordered returns the hash of values regardless of their order, so that
+// sets with the same elements hash identically however they are iterated.
+// Every element is hashed with the given hash function.
+func Unordered[T any](seed maphash.Seed, values iter.Seq[T], hash func(seed maphash.Seed, v T) uint64) uint64 {
+	var sum, count uint64
+	for v := range values {
+		sum += hash(seed, v)
+		count++
+	}
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeUint64(&h, sum)
+	writeUint64(&h, count)
+	return h.Sum64()
+}
+
+// Deep returns a hash of v such that values reported equal by
+// reflect.DeepEqual hash identically. Like reflect.DeepEqual, it follows
+// pointers and compares the contents of maps and slices rather than their
+// identity, and it treats 0.0 and -0.0 as equal.
+//
+// A pointer, map or slice that refers back to a value being hashed is not
+// followed again, so cyclic values that reflect.DeepEqual reports equal only
+// hash identically if their cycles have the same length.
+func Deep[T any](seed maphash.Seed, v T) uint64 {
+	d := deepHasher{seed: seed}
+	d.h.SetSeed(seed)
+	d.write(reflect.ValueOf(&v).Elem())
+	return d.h.Sum64()
+}
+
+// deepHasher writes values to h, keeping track of the pointers it is
+// following so that it does not loop on cyclic values.
+type deepHasher struct {
+	seed     maphash.Seed
+	h        maphash.Hash
+	visiting map[visit]bool
+}
+
+// visit identifies a pointer, map or slice being followed. Slices sharing
+// their first element differ by type or length.
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+func (d *deepHasher) write(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			d.h.WriteByte(1)
+		} else {
+			d.h.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(&d.h, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(&d.h, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(&d.h, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeFloat(&d.h, real(v.Complex()))
+		writeFloat(&d.h, imag(v.Complex()))
+	case reflect.String:
+		d.h.WriteString(v.String())
+	case reflect.Array:
+		for i := range v.Len() {
+			d.write(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			d.write(v.Field(i))
+		}
+	case reflect.Slice:
+		if v.IsNil() {
+			d.h.WriteByte(0)
+			return
+		}
+		writeUint64(&d.h, uint64(v.Len()))
+		if v.Len() > 0 && d.enter(v) {
+			for i := range v.Len() {
+				d.write(v.Index(i))
+			}
+			d.leave(v)
+		}
+	case reflect.Pointer:
+		if v.IsNil() {
+			d.h.WriteByte(0)
+			return
+		}
+		d.h.WriteByte(1)
+		if d.enter(v) {
+			d.write(v.Elem())
+			d.leave(v)
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			d.h.WriteByte(0)
+			return
+		}
+		d.h.WriteByte(1)
+		d.write(v.Elem())
+	case reflect.Map:
+		if v.IsNil() {
+			d.h.WriteByte(0)
+			return
+		}
+		writeUint64(&d.h, uint64(v.Len()))
+		if d.enter(v) {
+			writeUint64(&d.h, d.entries(v))
+			d.leave(v)
+		}
+	case reflect.Func:
+		// Functions are only deeply equal if both are nil.
+		if v.IsNil() {
+			d.h.WriteByte(0)
+		} else {
+			d.h.WriteByte(1)
+		}
+	case reflect.Chan, reflect.UnsafePointer:
+		writeUint64(&d.h, uint64(v.Pointer()))
+	}
+}
+
+// entries returns the sum of the hashes of the entries of the map, which does
+// not depend on their order.
+func (d *deepHasher) entries(v reflect.Value) uint64 {
+	var sum uint64
+	iter := v.MapRange()
+	for iter.Next() {
+		entry := deepHasher{seed: d.seed, visiting: d.visiting}
+		entry.h.SetSeed(d.seed)
+		entry.write(iter.Key())
+		entry.write(iter.Value())
+		sum += entry.h.Sum64()
+	}
+	return sum
+}
+
+// enter records that the value is being followed. Returns false if it
+// already is.
+func (d *deepHasher) enter(v reflect.Value) bool {
+	key := visit{ptr: v.Pointer(), typ: v.Type()}
+	if v.Kind() == reflect.Slice {
+		key.len = v.Len()
+	}
+	if d.visiting[key] {
+		return false
+	}
+	if d.visiting == nil {
+		d.visiting = make(map[visit]bool)
+	}
+	d.visiting[key] = true
+	return true
+}
+
+func (d *deepHasher) leave(v reflect.Value) {
+	key := visit{ptr: v.Pointer(), typ: v.Type()}
+	if v.Kind() == reflect.Slice {
+		key.len = v.Len()
+	}
+	delete(d.visiting, key)
+}
+
+// writeFloat writes f so that 0.0 and -0.0, which are equal, are written
+// identically.
+func writeFloat(h *maphash.Hash, f float64) {
+	if f == 0 {
+		f = 0
+	}
+	writeUint64(h, math.Float64bits(f))
+}
+
+func writeUint64(h *maphash.Hash, v uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	h.Write(b[:])
+}
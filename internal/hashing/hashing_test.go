@@ -0,0 +1,73 @@
+package hashing
+
+import (
+	"hash/maphash"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestOrdered(t *testing.T) {
+	seed := maphash.MakeSeed()
+	if Ordered(seed, slices.Values([]int{1, 2, 3}), maphash.Comparable[int]) != Ordered(seed, slices.Values([]int{1, 2, 3}), maphash.Comparable[int]) {
+		t.Fatalf("Expected equal sequences to hash identically")
+	}
+	if Ordered(seed, slices.Values([]int{1, 2, 3}), maphash.Comparable[int]) == Ordered(seed, slices.Values([]int{3, 2, 1}), maphash.Comparable[int]) {
+		t.Fatalf("Expected reordered sequences to hash differently")
+	}
+	if Ordered(seed, slices.Values([]string{"ab", "c"}), maphash.String) == Ordered(seed, slices.Values([]string{"a", "bc"}), maphash.String) {
+		t.Fatalf("Expected element boundaries to affect the hash")
+	}
+}
+
+func TestUnordered(t *testing.T) {
+	seed := maphash.MakeSeed()
+	if Unordered(seed, slices.Values([]int{1, 2, 3}), maphash.Comparable[int]) != Unordered(seed, slices.Values([]int{3, 1, 2}), maphash.Comparable[int]) {
+		t.Fatalf("Expected reordered sets to hash identically")
+	}
+	if Unordered(seed, slices.Values([]int{1, 2}), maphash.Comparable[int]) == Unordered(seed, slices.Values([]int{1, 2, 3}), maphash.Comparable[int]) {
+		t.Fatalf("Expected different sets to hash differently")
+	}
+}
+
+func TestDeep(t *testing.T) {
+	type point struct{ x, y int }
+	type node struct {
+		value int
+		next  *node
+	}
+	one, other := 1, 1
+	cycle := &node{value: 1}
+	cycle.next = cycle
+	otherCycle := &node{value: 1}
+	otherCycle.next = otherCycle
+
+	scenarios := []struct {
+		name string
+		a, b any
+		same bool
+	}{
+		{name: "equal structs", a: point{1, 2}, b: point{1, 2}, same: true},
+		{name: "different structs", a: point{1, 2}, b: point{2, 1}},
+		{name: "deeply equal slices", a: []int{1, 2}, b: []int{1, 2}, same: true},
+		{name: "distinct pointers to equal values", a: &one, b: &other, same: true},
+		{name: "signed zeros", a: 0.0, b: math.Copysign(0, -1), same: true},
+		{name: "maps in different insertion order", a: map[string]int{"a": 1, "b": 2}, b: map[string]int{"b": 2, "a": 1}, same: true},
+		{name: "different maps", a: map[string]int{"a": 1}, b: map[string]int{"a": 2}},
+		{name: "cyclic values", a: cycle, b: otherCycle, same: true},
+		{name: "different strings", a: "a", b: "b"},
+	}
+
+	seed := maphash.MakeSeed()
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			if reflect.DeepEqual(s.a, s.b) != s.same {
+				t.Fatalf("Expected reflect.DeepEqual to report %v", s.same)
+			}
+			if same := Deep(seed, s.a) == Deep(seed, s.b); same != s.same {
+				t.Fatalf("Expected identical hashes to be %v, but got %v", s.same, same)
+			}
+		})
+	}
+}
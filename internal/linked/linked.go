@@ -0,0 +1,84 @@
+// Package linked provides the insertion-ordered list behind the linked hash
+// collections. Unlike ranging over a list.LinkedList, ranging over it allows
+// any element to be removed while the range is in progress.
+package linked
+
+import (
+	"iter"
+
+	"github.com/elias8/go-gather/list"
+)
+
+// List is a list.LinkedList that keeps track of the ranges in progress over
+// it, so that removing an element moves the ranges about to visit it to the
+// next element.
+type List[T any] struct {
+	elements list.LinkedList[T]
+	cursors  map[*cursor[T]]struct{}
+}
+
+// cursor is the element a range in progress visits next.
+type cursor[T any] struct {
+	next *list.Element[T]
+}
+
+// New returns an empty List.
+func New[T any]() *List[T] {
+	return &List[T]{elements: list.NewLinkedList[T](), cursors: make(map[*cursor[T]]struct{})}
+}
+
+// PushBack appends the value and returns its element.
+func (l *List[T]) PushBack(value T) *list.Element[T] {
+	return l.elements.PushBack(value)
+}
+
+// Remove removes the element from the list.
+func (l *List[T]) Remove(e *list.Element[T]) {
+	for c := range l.cursors {
+		if c.next == e {
+			c.next = e.Next()
+		}
+	}
+	l.elements.RemoveElement(e)
+}
+
+// Clear removes all elements from the list.
+func (l *List[T]) Clear() {
+	for c := range l.cursors {
+		c.next = nil
+	}
+	l.elements.Clear()
+}
+
+// Front returns the first element of the list, or nil if it is empty.
+func (l *List[T]) Front() *list.Element[T] {
+	return l.elements.Front()
+}
+
+// Size returns the number of elements in the list.
+func (l *List[T]) Size() int {
+	return l.elements.Size()
+}
+
+// Values returns the values of the list, in order.
+func (l *List[T]) Values() []T {
+	return l.elements.Values()
+}
+
+// All returns an iterator over the values of the list, in order. Elements
+// removed while ranging are not visited, and elements added while ranging are
+// visited, unless they are added while the last element is being visited.
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		c := &cursor[T]{next: l.elements.Front()}
+		l.cursors[c] = struct{}{}
+		defer delete(l.cursors, c)
+		for c.next != nil {
+			current := c.next
+			c.next = current.Next()
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package linked
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/elias8/go-gather/list"
+)
+
+func TestList_All(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		modify   func(l *List[int], elements []*list.Element[int], v int)
+		expected []int
+	}{
+		{
+			name:     "unmodified",
+			modify:   func(*List[int], []*list.Element[int], int) {},
+			expected: []int{0, 1, 2, 3},
+		},
+		{
+			name: "remove current",
+			modify: func(l *List[int], elements []*list.Element[int], v int) {
+				l.Remove(elements[v])
+			},
+			expected: []int{0, 1, 2, 3},
+		},
+		{
+			name: "remove next",
+			modify: func(l *List[int], elements []*list.Element[int], v int) {
+				if v == 0 {
+					l.Remove(elements[1])
+				}
+			},
+			expected: []int{0, 2, 3},
+		},
+		{
+			name: "remove current and next",
+			modify: func(l *List[int], elements []*list.Element[int], v int) {
+				if v == 1 {
+					l.Remove(elements[1])
+					l.Remove(elements[2])
+				}
+			},
+			expected: []int{0, 1, 3},
+		},
+		{
+			name: "add",
+			modify: func(l *List[int], _ []*list.Element[int], v int) {
+				if v == 0 {
+					l.PushBack(4)
+				}
+			},
+			expected: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name: "clear",
+			modify: func(l *List[int], _ []*list.Element[int], v int) {
+				if v == 1 {
+					l.Clear()
+				}
+			},
+			expected: []int{0, 1},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			l := New[int]()
+			var elements []*list.Element[int]
+			for i := range 4 {
+				elements = append(elements, l.PushBack(i))
+			}
+			var visited []int
+			for v := range l.All() {
+				visited = append(visited, v)
+				s.modify(l, elements, v)
+			}
+			if !slices.Equal(visited, s.expected) {
+				t.Fatalf("Expected to visit %v, but got %v", s.expected, visited)
+			}
+			if len(l.cursors) != 0 {
+				t.Fatalf("Expected the range to be forgotten once it ends")
+			}
+		})
+	}
+}
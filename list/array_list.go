@@ -2,15 +2,92 @@ package list
 
 import (
 	"fmt"
-	"reflect"
+	"hash/maphash"
+	"iter"
+	"slices"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
 )
 
 type arrayList[T any] struct {
 	elements []T
+	modCount int
+	equal    func(a, b T) bool
+	hash     func(seed maphash.Seed, v T) uint64
+}
+
+// NewArrayList returns an empty ArrayList. Elements are compared using
+// reflect.DeepEqual and hashed using base.DeepHash.
+func NewArrayList[T any]() ArrayList[T] {
+	return NewArrayListFunc(base.DeepEqual[T], base.DeepHash[T])
+}
+
+// NewArrayListFunc returns an empty ArrayList that compares elements using the
+// given equal function and hashes them using the given hash function, which
+// must return the same hash for elements that equal reports equal. If equal
+// is nil, reflect.DeepEqual is used, and if hash is nil, base.DeepHash is
+// used.
+func NewArrayListFunc[T any](equal func(a, b T) bool, hash func(seed maphash.Seed, v T) uint64) ArrayList[T] {
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	if hash == nil {
+		hash = base.DeepHash[T]
+	}
+	return &arrayList[T]{equal: equal, hash: hash}
+}
+
+// NewArrayListWithCapacity returns an empty ArrayList whose backing array can
+// hold n elements before it has to grow. Elements are compared using
+// reflect.DeepEqual and hashed using base.DeepHash. It panics if n is
+// negative.
+func NewArrayListWithCapacity[T any](n int) ArrayList[T] {
+	return NewArrayListWithCapacityFunc[T](n, base.DeepEqual[T], base.DeepHash[T])
+}
+
+// NewArrayListWithCapacityFunc is like NewArrayListWithCapacity, but compares
+// and hashes elements using the given functions, as NewArrayListFunc does.
+func NewArrayListWithCapacityFunc[T any](n int, equal func(a, b T) bool, hash func(seed maphash.Seed, v T) uint64) ArrayList[T] {
+	if n < 0 {
+		panic("list: negative capacity")
+	}
+	return NewArrayListFromFunc(make([]T, 0, n), equal, hash)
 }
 
-func NewArrayList[T any]() List[T] {
-	return &arrayList[T]{}
+// NewArrayListFrom returns an ArrayList containing the elements of values, in
+// order. The list takes ownership of values and uses it as its backing array,
+// so the caller should not use values afterwards. Elements are compared using
+// reflect.DeepEqual and hashed using base.DeepHash.
+func NewArrayListFrom[T any](values []T) ArrayList[T] {
+	return NewArrayListFromFunc(values, base.DeepEqual[T], base.DeepHash[T])
+}
+
+// NewArrayListFromFunc is like NewArrayListFrom, but compares and hashes
+// elements using the given functions, as NewArrayListFunc does.
+func NewArrayListFromFunc[T any](values []T, equal func(a, b T) bool, hash func(seed maphash.Seed, v T) uint64) ArrayList[T] {
+	a := NewArrayListFunc(equal, hash).(*arrayList[T])
+	a.elements = values
+	return a
+}
+
+// NewComparableArrayList returns an empty ArrayList that compares elements
+// using the == operator and hashes them using maphash.Comparable.
+func NewComparableArrayList[T comparable]() ArrayList[T] {
+	return NewArrayListFunc(base.Equal[T], base.Hash[T])
+}
+
+// NewComparableArrayListWithCapacity is like NewArrayListWithCapacity, but
+// compares elements using the == operator and hashes them using
+// maphash.Comparable.
+func NewComparableArrayListWithCapacity[T comparable](n int) ArrayList[T] {
+	return NewArrayListWithCapacityFunc(n, base.Equal[T], base.Hash[T])
+}
+
+// NewComparableArrayListFrom is like NewArrayListFrom, but compares elements
+// using the == operator and hashes them using maphash.Comparable.
+func NewComparableArrayListFrom[T comparable](values []T) ArrayList[T] {
+	return NewArrayListFromFunc(values, base.Equal[T], base.Hash[T])
 }
 
 func (a *arrayList[T]) Size() int {
@@ -23,7 +100,7 @@ func (a *arrayList[T]) IsEmpty() bool {
 
 func (a *arrayList[T]) Contains(element T) bool {
 	for _, e := range a.elements {
-		if reflect.DeepEqual(e, element) {
+		if a.equal(e, element) {
 			return true
 		}
 	}
@@ -48,22 +125,77 @@ func (a *arrayList[T]) Values() []T {
 
 func (a *arrayList[T]) Add(element T) {
 	a.elements = append(a.elements, element)
+	a.modCount++
+}
+
+func (a *arrayList[T]) AddAt(index int, element T) bool {
+	return a.AddAllAt(index, element)
+}
+
+func (a *arrayList[T]) AddAll(elements ...T) {
+	a.elements = append(a.elements, elements...)
+	a.modCount++
+}
+
+func (a *arrayList[T]) AddAllAt(index int, elements ...T) bool {
+	if index < 0 || index > len(a.elements) {
+		return false
+	}
+	a.elements = slices.Insert(a.elements, index, elements...)
+	a.modCount++
+	return true
 }
 
 func (a *arrayList[T]) Clear() {
 	a.elements = nil
+	a.modCount++
 }
 
 func (a *arrayList[T]) Remove(element T) bool {
 	for i, e := range a.elements {
-		if reflect.DeepEqual(e, element) {
-			a.elements = append(a.elements[:i], a.elements[i+1:]...)
+		if a.equal(e, element) {
+			a.elements = slices.Delete(a.elements, i, i+1)
+			a.modCount++
 			return true
 		}
 	}
 	return false
 }
 
+func (a *arrayList[T]) RemoveAt(index int) (*T, bool) {
+	if index < 0 || index >= len(a.elements) {
+		return nil, false
+	}
+	removed := a.elements[index]
+	a.elements = slices.Delete(a.elements, index, index+1)
+	a.modCount++
+	return &removed, true
+}
+
+func (a *arrayList[T]) RemoveRange(from, to int) bool {
+	if from < 0 || to > len(a.elements) || from > to {
+		return false
+	}
+	a.elements = slices.Delete(a.elements, from, to)
+	a.modCount++
+	return true
+}
+
+func (a *arrayList[T]) RemoveIf(predicate func(element T) bool) bool {
+	size := len(a.elements)
+	modCount := a.modCount
+	a.elements = slices.DeleteFunc(a.elements, func(e T) bool {
+		remove := predicate(e)
+		checkModifications(a, modCount)
+		return remove
+	})
+	if len(a.elements) == size {
+		return false
+	}
+	a.modCount++
+	return true
+}
+
 func (a *arrayList[T]) Set(index int, element T) (*T, bool) {
 	if index < 0 || index >= len(a.elements) {
 		return nil, false
@@ -82,7 +214,7 @@ func (a *arrayList[T]) Get(index int) (*T, bool) {
 
 func (a *arrayList[T]) IndexOf(element T) (int, bool) {
 	for i, e := range a.elements {
-		if reflect.DeepEqual(e, element) {
+		if a.equal(e, element) {
 			return i, true
 		}
 	}
@@ -91,9 +223,152 @@ func (a *arrayList[T]) IndexOf(element T) (int, bool) {
 
 func (a *arrayList[T]) LastIndexOf(element T) (int, bool) {
 	for i := len(a.elements) - 1; i >= 0; i-- {
-		if reflect.DeepEqual(a.elements[i], element) {
+		if a.equal(a.elements[i], element) {
 			return i, true
 		}
 	}
 	return -1, false
 }
+
+func (a *arrayList[T]) Iterator() base.Iterator[T] {
+	return &arrayListIterator[T]{list: a, last: -1, modCount: a.modCount}
+}
+
+func (a *arrayList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		modCount := a.modCount
+		for _, e := range a.elements {
+			if !yield(e) {
+				return
+			}
+			checkModifications(a, modCount)
+		}
+	}
+}
+
+func (a *arrayList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		modCount := a.modCount
+		for i := len(a.elements) - 1; i >= 0; i-- {
+			if !yield(i, a.elements[i]) {
+				return
+			}
+			checkModifications(a, modCount)
+		}
+	}
+}
+
+// arrayListIterator is fail-fast: Next and Remove panic with
+// ErrConcurrentModification if the list was structurally modified other than
+// through the iterator after it was created.
+type arrayListIterator[T any] struct {
+	list     *arrayList[T]
+	cursor   int
+	last     int
+	modCount int
+}
+
+func (it *arrayListIterator[T]) HasNext() bool {
+	return it.cursor < len(it.list.elements)
+}
+
+func (it *arrayListIterator[T]) Next() (*T, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	checkModifications(it.list, it.modCount)
+	it.last = it.cursor
+	it.cursor++
+	return &it.list.elements[it.last], true
+}
+
+func (it *arrayListIterator[T]) Remove() bool {
+	if it.last < 0 {
+		return false
+	}
+	checkModifications(it.list, it.modCount)
+	it.list.elements = slices.Delete(it.list.elements, it.last, it.last+1)
+	it.list.modCount++
+	it.modCount = it.list.modCount
+	it.cursor = it.last
+	it.last = -1
+	return true
+}
+
+func (a *arrayList[T]) Sort(compare func(a, b T) int) {
+	modCount := a.modCount
+	slices.SortFunc(a.elements, compare)
+	checkModifications(a, modCount)
+	a.modCount++
+}
+
+func (a *arrayList[T]) SortStable(compare func(a, b T) int) {
+	modCount := a.modCount
+	slices.SortStableFunc(a.elements, compare)
+	checkModifications(a, modCount)
+	a.modCount++
+}
+
+func (a *arrayList[T]) BinarySearch(element T, compare func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(a.elements, element, compare)
+}
+
+func (a *arrayList[T]) Cap() int {
+	return cap(a.elements)
+}
+
+func (a *arrayList[T]) EnsureCapacity(n int) {
+	a.elements = slices.Grow(a.elements, n)
+}
+
+func (a *arrayList[T]) TrimToSize() {
+	if len(a.elements) < cap(a.elements) {
+		a.elements = slices.Clip(slices.Clone(a.elements))
+	}
+}
+
+func (a *arrayList[T]) ClearRetain() {
+	clear(a.elements)
+	a.elements = a.elements[:0]
+	a.modCount++
+}
+
+func (a *arrayList[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(a.elements)
+}
+
+func (a *arrayList[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	a.replace(values)
+	return nil
+}
+
+func (a *arrayList[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(a.elements)
+}
+
+func (a *arrayList[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	a.replace(values)
+	return nil
+}
+
+func (a *arrayList[T]) GobEncode() ([]byte, error) {
+	return a.MarshalBinary()
+}
+
+func (a *arrayList[T]) GobDecode(data []byte) error {
+	return a.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the list with the decoded values.
+func (a *arrayList[T]) replace(values []T) {
+	a.elements = values
+	a.modCount++
+}
@@ -1,6 +1,11 @@
 package list
 
 import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"hash/maphash"
 	"reflect"
 	"testing"
 )
@@ -41,6 +46,137 @@ func TestNewArrayList(t *testing.T) {
 	}
 }
 
+func TestNewArrayListWithCapacity(t *testing.T) {
+	list := NewArrayListWithCapacity[int](16)
+	if list.Size() != 0 {
+		t.Fatalf("NewArrayListWithCapacity() should return an empty List, got size %d", list.Size())
+	}
+	if list.Cap() != 16 {
+		t.Fatalf("Expected capacity 16, got %d", list.Cap())
+	}
+	for i := range 16 {
+		list.Add(i)
+	}
+	if list.Cap() != 16 {
+		t.Fatalf("Expected adding 16 elements not to grow the list, got capacity %d", list.Cap())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Expected NewArrayListWithCapacity(-1) to panic")
+		}
+	}()
+	NewArrayListWithCapacity[int](-1)
+}
+
+func TestNewArrayListFrom(t *testing.T) {
+	values := make([]int, 3, 8)
+	copy(values, []int{1, 2, 3})
+	list := NewArrayListFrom(values)
+	arrayListScenario[int]{expected: []int{1, 2, 3}}.test(list, t)
+	if list.Cap() != 8 {
+		t.Fatalf("Expected the list to use the capacity of the given slice, got %d", list.Cap())
+	}
+	if !list.Contains(2) {
+		t.Fatalf("Expected list to contain 2")
+	}
+
+	empty := NewArrayListFrom[int](nil)
+	if !empty.IsEmpty() {
+		t.Fatalf("Expected a list created from nil to be empty, got size %d", empty.Size())
+	}
+}
+
+func TestNewArrayListFunc(t *testing.T) {
+	type entity struct {
+		id   int
+		name string
+	}
+	list := NewArrayListFunc(
+		func(a, b entity) bool { return a.id == b.id },
+		func(seed maphash.Seed, e entity) uint64 { return maphash.Comparable(seed, e.id) },
+	)
+	list.Add(entity{id: 1, name: "first"})
+	list.Add(entity{id: 2, name: "second"})
+
+	if !list.Contains(entity{id: 2, name: "renamed"}) {
+		t.Fatalf("Expected list to contain entity with id 2")
+	}
+	if index, found := list.IndexOf(entity{id: 2}); !found || index != 1 {
+		t.Fatalf("Expected entity with id 2 at index 1, got %d", index)
+	}
+	if !list.Remove(entity{id: 1}) {
+		t.Fatalf("Expected entity with id 1 to be removed")
+	}
+	if list.Size() != 1 {
+		t.Fatalf("Expected size 1, got %d", list.Size())
+	}
+}
+
+func TestNewArrayListFunc_Nil(t *testing.T) {
+	list := NewArrayListFunc[[]int](nil, nil)
+	list.Add([]int{1, 2})
+	if !list.Contains([]int{1, 2}) {
+		t.Fatalf("Expected nil equal function to fall back to deep equality")
+	}
+}
+
+func TestNewArrayListFromFunc(t *testing.T) {
+	type entity struct {
+		id   int
+		name string
+	}
+	equal := func(a, b entity) bool { return a.id == b.id }
+	hash := func(seed maphash.Seed, e entity) uint64 { return maphash.Comparable(seed, e.id) }
+
+	list := NewArrayListFromFunc([]entity{{1, "first"}, {2, "second"}}, equal, hash)
+	if !list.Contains(entity{id: 2, name: "renamed"}) {
+		t.Fatalf("Expected list to contain entity with id 2")
+	}
+	other := NewArrayListWithCapacityFunc(4, equal, hash)
+	other.AddAll(entity{id: 1}, entity{id: 2})
+	if other.Cap() != 4 {
+		t.Fatalf("Expected capacity 4, got %d", other.Cap())
+	}
+	seed := maphash.MakeSeed()
+	if !list.Equals(other) || list.Hash(seed) != other.Hash(seed) {
+		t.Fatalf("Expected lists with the same ids to be equal and hash alike")
+	}
+
+	if NewArrayListFromFunc[[]int](nil, nil, nil).Contains(nil) {
+		t.Fatalf("Expected a list created from nil to be empty")
+	}
+}
+
+func TestNewComparableArrayListFrom(t *testing.T) {
+	list := NewComparableArrayListFrom([]string{"a", "b"})
+	withCapacity := NewComparableArrayListWithCapacity[string](2)
+	withCapacity.AddAll("a", "b")
+	seed := maphash.MakeSeed()
+	if !list.Equals(withCapacity) || list.Hash(seed) != withCapacity.Hash(seed) {
+		t.Fatalf("Expected lists with the same elements to be equal and hash alike")
+	}
+	empty := NewComparableArrayList[string]()
+	empty.AddAll("a", "b")
+	if list.Hash(seed) != empty.Hash(seed) {
+		t.Fatalf("Expected comparable lists to hash alike however they are created")
+	}
+}
+
+func TestNewComparableArrayList(t *testing.T) {
+	list := NewComparableArrayList[string]()
+	list.Add("a")
+	list.Add("b")
+	list.Add("a")
+
+	if index, found := list.LastIndexOf("a"); !found || index != 2 {
+		t.Fatalf("Expected last index of a to be 2, got %d", index)
+	}
+	if list.Contains("c") {
+		t.Fatalf("Expected list to not contain c")
+	}
+}
+
 func TestArrayListAdd(t *testing.T) {
 	scenarios := []arrayListScenario[int]{
 		{name: "Add 1 element", value: []int{1}, expected: []int{1}},
@@ -654,3 +790,403 @@ func TestArrayList_String(t *testing.T) {
 		})
 	}
 }
+
+func TestArrayList_Iterator(t *testing.T) {
+	list := NewArrayList[int]()
+	for _, e := range []int{1, 2, 3, 4} {
+		list.Add(e)
+	}
+
+	it := list.Iterator()
+	if it.Remove() {
+		t.Fatalf("Expected Remove to fail before calling Next")
+	}
+	var visited []int
+	for it.HasNext() {
+		element, ok := it.Next()
+		if !ok {
+			t.Fatalf("Expected Next to return an element")
+		}
+		visited = append(visited, *element)
+		if *element%2 == 0 && !it.Remove() {
+			t.Fatalf("Expected Remove to remove %d", *element)
+		}
+	}
+	if it.Remove() {
+		t.Fatalf("Expected Remove to fail twice in a row")
+	}
+	if _, ok := it.Next(); ok {
+		t.Fatalf("Expected Next to fail after the last element")
+	}
+
+	if !reflect.DeepEqual(visited, []int{1, 2, 3, 4}) {
+		t.Fatalf("Expected to visit [1 2 3 4], got %v", visited)
+	}
+	arrayListScenario[int]{expected: []int{1, 3}}.test(list, t)
+}
+
+func TestArrayList_All(t *testing.T) {
+	list := NewArrayList[int]()
+	for _, e := range []int{1, 2, 3} {
+		list.Add(e)
+	}
+
+	var visited []int
+	for e := range list.All() {
+		visited = append(visited, e)
+		if e == 2 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(visited, []int{1, 2}) {
+		t.Fatalf("Expected to visit [1 2], got %v", visited)
+	}
+}
+
+func TestArrayList_Backward(t *testing.T) {
+	list := NewArrayList[int]()
+	for _, e := range []int{1, 2, 3} {
+		list.Add(e)
+	}
+
+	var indexes, visited []int
+	for i, e := range list.Backward() {
+		indexes = append(indexes, i)
+		visited = append(visited, e)
+	}
+	if !reflect.DeepEqual(indexes, []int{2, 1, 0}) {
+		t.Fatalf("Expected indexes [2 1 0], got %v", indexes)
+	}
+	if !reflect.DeepEqual(visited, []int{3, 2, 1}) {
+		t.Fatalf("Expected elements [3 2 1], got %v", visited)
+	}
+}
+
+func TestArrayList_AddAt(t *testing.T) {
+	scenarios := []struct {
+		arrayListScenario[int]
+		index         int
+		shouldSucceed bool
+	}{
+		{arrayListScenario: arrayListScenario[int]{name: "AddAt on empty list", value: []int{}, expected: []int{9}}, index: 0, shouldSucceed: true},
+		{arrayListScenario: arrayListScenario[int]{name: "AddAt beginning", value: []int{1, 2}, expected: []int{9, 1, 2}}, index: 0, shouldSucceed: true},
+		{arrayListScenario: arrayListScenario[int]{name: "AddAt middle", value: []int{1, 2}, expected: []int{1, 9, 2}}, index: 1, shouldSucceed: true},
+		{arrayListScenario: arrayListScenario[int]{name: "AddAt end", value: []int{1, 2}, expected: []int{1, 2, 9}}, index: 2, shouldSucceed: true},
+		{arrayListScenario: arrayListScenario[int]{name: "AddAt negative index", value: []int{1, 2}, expected: []int{1, 2}}, index: -1, shouldSucceed: false},
+		{arrayListScenario: arrayListScenario[int]{name: "AddAt past the end", value: []int{1, 2}, expected: []int{1, 2}}, index: 3, shouldSucceed: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			list := NewArrayList[int]()
+			list.AddAll(s.value...)
+			if success := list.AddAt(s.index, 9); success != s.shouldSucceed {
+				t.Fatalf("Expected AddAt(%d) to return %v", s.index, s.shouldSucceed)
+			}
+			s.test(list, t)
+		})
+	}
+}
+
+func TestArrayList_AddAll(t *testing.T) {
+	list := NewArrayList[int]()
+	list.AddAll()
+	list.AddAll(1, 2)
+	list.AddAll(3)
+	arrayListScenario[int]{expected: []int{1, 2, 3}}.test(list, t)
+}
+
+func TestArrayList_AddAllAt(t *testing.T) {
+	scenarios := []struct {
+		arrayListScenario[int]
+		index         int
+		shouldSucceed bool
+	}{
+		{arrayListScenario: arrayListScenario[int]{name: "AddAllAt on empty list", value: []int{}, expected: []int{8, 9}}, index: 0, shouldSucceed: true},
+		{arrayListScenario: arrayListScenario[int]{name: "AddAllAt middle", value: []int{1, 2}, expected: []int{1, 8, 9, 2}}, index: 1, shouldSucceed: true},
+		{arrayListScenario: arrayListScenario[int]{name: "AddAllAt end", value: []int{1, 2}, expected: []int{1, 2, 8, 9}}, index: 2, shouldSucceed: true},
+		{arrayListScenario: arrayListScenario[int]{name: "AddAllAt invalid index", value: []int{1, 2}, expected: []int{1, 2}}, index: 5, shouldSucceed: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			list := NewArrayList[int]()
+			list.AddAll(s.value...)
+			if success := list.AddAllAt(s.index, 8, 9); success != s.shouldSucceed {
+				t.Fatalf("Expected AddAllAt(%d) to return %v", s.index, s.shouldSucceed)
+			}
+			s.test(list, t)
+		})
+	}
+}
+
+func TestArrayList_RemoveAt(t *testing.T) {
+	scenarios := []struct {
+		arrayListScenario[int]
+		index      int
+		shouldFind bool
+	}{
+		{arrayListScenario: arrayListScenario[int]{name: "RemoveAt on empty list", value: []int{}, expected: []int{}}, index: 0, shouldFind: false},
+		{arrayListScenario: arrayListScenario[int]{name: "RemoveAt beginning", value: []int{1, 2, 3}, expected: []int{2, 3}}, index: 0, shouldFind: true},
+		{arrayListScenario: arrayListScenario[int]{name: "RemoveAt middle", value: []int{1, 2, 3}, expected: []int{1, 3}}, index: 1, shouldFind: true},
+		{arrayListScenario: arrayListScenario[int]{name: "RemoveAt end", value: []int{1, 2, 3}, expected: []int{1, 2}}, index: 2, shouldFind: true},
+		{arrayListScenario: arrayListScenario[int]{name: "RemoveAt invalid index", value: []int{1, 2, 3}, expected: []int{1, 2, 3}}, index: 3, shouldFind: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			list := NewArrayList[int]()
+			list.AddAll(s.value...)
+			removed, found := list.RemoveAt(s.index)
+			if found != s.shouldFind {
+				t.Fatalf("Expected RemoveAt(%d) to return %v", s.index, s.shouldFind)
+			}
+			if found && *removed != s.value[s.index] {
+				t.Fatalf("Expected removed element to be %v, got %v", s.value[s.index], *removed)
+			}
+			s.test(list, t)
+		})
+	}
+}
+
+func TestArrayList_RemoveRange(t *testing.T) {
+	scenarios := []struct {
+		arrayListScenario[int]
+		from, to      int
+		shouldSucceed bool
+	}{
+		{arrayListScenario: arrayListScenario[int]{name: "RemoveRange empty range", value: []int{1, 2, 3}, expected: []int{1, 2, 3}}, from: 1, to: 1, shouldSucceed: true},
+		{arrayListScenario: arrayListScenario[int]{name: "RemoveRange middle", value: []int{1, 2, 3, 4}, expected: []int{1, 4}}, from: 1, to: 3, shouldSucceed: true},
+		{arrayListScenario: arrayListScenario[int]{name: "RemoveRange all", value: []int{1, 2, 3}, expected: []int{}}, from: 0, to: 3, shouldSucceed: true},
+		{arrayListScenario: arrayListScenario[int]{name: "RemoveRange reversed", value: []int{1, 2, 3}, expected: []int{1, 2, 3}}, from: 2, to: 1, shouldSucceed: false},
+		{arrayListScenario: arrayListScenario[int]{name: "RemoveRange past the end", value: []int{1, 2, 3}, expected: []int{1, 2, 3}}, from: 1, to: 4, shouldSucceed: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			list := NewArrayList[int]()
+			list.AddAll(s.value...)
+			if success := list.RemoveRange(s.from, s.to); success != s.shouldSucceed {
+				t.Fatalf("Expected RemoveRange(%d, %d) to return %v", s.from, s.to, s.shouldSucceed)
+			}
+			s.test(list, t)
+		})
+	}
+}
+
+func TestArrayList_RemoveIf(t *testing.T) {
+	list := NewArrayList[int]()
+	list.AddAll(1, 2, 3, 4, 5)
+
+	if !list.RemoveIf(func(e int) bool { return e%2 == 0 }) {
+		t.Fatalf("Expected RemoveIf to remove the even elements")
+	}
+	if list.RemoveIf(func(e int) bool { return e > 5 }) {
+		t.Fatalf("Expected RemoveIf to not remove any element")
+	}
+	arrayListScenario[int]{expected: []int{1, 3, 5}}.test(list, t)
+}
+
+func TestArrayList_Sort(t *testing.T) {
+	scenarios := []arrayListScenario[int]{
+		{name: "Sort empty list", value: []int{}, expected: []int{}},
+		{name: "Sort 1 element list", value: []int{1}, expected: []int{1}},
+		{name: "Sort multiple element list", value: []int{3, 1, 2, 1}, expected: []int{1, 1, 2, 3}},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			list := NewArrayList[int]()
+			list.AddAll(s.value...)
+			list.Sort(func(a, b int) int { return a - b })
+			s.test(list, t)
+		})
+	}
+}
+
+func TestArrayList_SortStable(t *testing.T) {
+	list := NewArrayList[string]()
+	list.AddAll("bb", "a", "cc", "b", "aa")
+	list.SortStable(func(a, b string) int { return len(a) - len(b) })
+	arrayListScenario[string]{expected: []string{"a", "b", "bb", "cc", "aa"}}.test(list, t)
+}
+
+func TestArrayList_BinarySearch(t *testing.T) {
+	scenarios := []struct {
+		arrayListScenario[int]
+		element    int
+		index      int
+		shouldFind bool
+	}{
+		{arrayListScenario: arrayListScenario[int]{name: "BinarySearch on empty list", value: []int{}}, element: 1, index: 0, shouldFind: false},
+		{arrayListScenario: arrayListScenario[int]{name: "BinarySearch present element", value: []int{1, 3, 5}}, element: 3, index: 1, shouldFind: true},
+		{arrayListScenario: arrayListScenario[int]{name: "BinarySearch absent element", value: []int{1, 3, 5}}, element: 4, index: 2, shouldFind: false},
+		{arrayListScenario: arrayListScenario[int]{name: "BinarySearch past the end", value: []int{1, 3, 5}}, element: 6, index: 3, shouldFind: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			list := NewArrayList[int]()
+			list.AddAll(s.value...)
+			index, found := list.BinarySearch(s.element, func(a, b int) int { return a - b })
+			if found != s.shouldFind || index != s.index {
+				t.Fatalf("Expected (%d, %v), got (%d, %v)", s.index, s.shouldFind, index, found)
+			}
+		})
+	}
+}
+
+func TestArrayList_EnsureCapacity(t *testing.T) {
+	list := NewArrayList[int]()
+	list.AddAll(1, 2)
+	list.EnsureCapacity(10)
+	if list.Cap() < 12 {
+		t.Fatalf("Expected capacity of at least 12, got %d", list.Cap())
+	}
+	capacity := list.Cap()
+	list.EnsureCapacity(1)
+	if list.Cap() != capacity {
+		t.Fatalf("Expected EnsureCapacity not to shrink or grow the list, got capacity %d", list.Cap())
+	}
+	arrayListScenario[int]{expected: []int{1, 2}}.test(list, t)
+}
+
+func TestArrayList_TrimToSize(t *testing.T) {
+	list := NewArrayListWithCapacity[int](32)
+	list.AddAll(1, 2, 3)
+	list.TrimToSize()
+	if list.Cap() != 3 {
+		t.Fatalf("Expected capacity 3 after TrimToSize, got %d", list.Cap())
+	}
+	arrayListScenario[int]{expected: []int{1, 2, 3}}.test(list, t)
+
+	empty := NewArrayListWithCapacity[int](8)
+	empty.TrimToSize()
+	if empty.Cap() != 0 {
+		t.Fatalf("Expected capacity 0 after trimming an empty list, got %d", empty.Cap())
+	}
+}
+
+func TestArrayList_ClearRetain(t *testing.T) {
+	list := NewArrayListWithCapacity[*int](4)
+	one := 1
+	list.AddAll(&one, &one, &one)
+	list.ClearRetain()
+	if !list.IsEmpty() {
+		t.Fatalf("Expected list to be empty after ClearRetain, got size %d", list.Size())
+	}
+	if list.Cap() != 4 {
+		t.Fatalf("Expected ClearRetain to keep capacity 4, got %d", list.Cap())
+	}
+	if backing := list.(*arrayList[*int]).elements[:3]; backing[0] != nil {
+		t.Fatalf("Expected ClearRetain to zero the removed elements")
+	}
+
+	it := list.Iterator()
+	list.Add(&one)
+	expectConcurrentModification(t, func() { it.Next() })
+}
+
+func BenchmarkArrayList_Add(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		list := NewArrayList[int]()
+		for i := range 1024 {
+			list.Add(i)
+		}
+	}
+}
+
+func BenchmarkArrayList_AddWithCapacity(b *testing.B) {
+	b.ReportAllocs()
+	for range b.N {
+		list := NewArrayListWithCapacity[int](1024)
+		for i := range 1024 {
+			list.Add(i)
+		}
+	}
+}
+
+func BenchmarkArrayList_Clear(b *testing.B) {
+	b.ReportAllocs()
+	list := NewArrayList[int]()
+	for range b.N {
+		for i := range 1024 {
+			list.Add(i)
+		}
+		list.Clear()
+	}
+}
+
+func BenchmarkArrayList_ClearRetain(b *testing.B) {
+	b.ReportAllocs()
+	list := NewArrayList[int]()
+	for range b.N {
+		for i := range 1024 {
+			list.Add(i)
+		}
+		list.ClearRetain()
+	}
+}
+
+func TestArrayList_JSON(t *testing.T) {
+	scenarios := []arrayListScenario[int]{
+		{name: "empty list", value: []int{}, expected: []int{}},
+		{name: "multiple element list", value: []int{3, 1, 2}, expected: []int{3, 1, 2}},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			list := NewArrayList[int]()
+			list.AddAll(s.value...)
+			data, err := json.Marshal(list)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if expected, _ := json.Marshal(s.expected); string(data) != string(expected) {
+				t.Fatalf("Expected %s, got %s", expected, data)
+			}
+
+			decoded := NewArrayList[int]()
+			decoded.Add(9)
+			if err := json.Unmarshal(data, decoded); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			s.test(decoded, t)
+		})
+	}
+}
+
+func TestArrayList_JSON_Invalid(t *testing.T) {
+	list := NewArrayList[int]()
+	list.Add(1)
+	if err := json.Unmarshal([]byte(`["a"]`), list); err == nil {
+		t.Fatalf("Expected an error when decoding strings into an integer list")
+	}
+	arrayListScenario[int]{expected: []int{1}}.test(list, t)
+}
+
+func TestArrayList_Gob(t *testing.T) {
+	list := NewArrayList[string]()
+	list.AddAll("a", "b")
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(list); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	decoded := NewArrayList[string]()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	arrayListScenario[string]{expected: []string{"a", "b"}}.test(decoded, t)
+
+	data, err := list.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	decoded = NewArrayList[string]()
+	if err := decoded.(encoding.BinaryUnmarshaler).UnmarshalBinary(data); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	arrayListScenario[string]{expected: []string{"a", "b"}}.test(decoded, t)
+}
@@ -1,9 +1,23 @@
 package list
 
 import (
+	"errors"
+	"hash/maphash"
+	"iter"
+
 	"github.com/elias8/go-gather/base"
 )
 
+// ErrConcurrentModification is the value lists and their views panic with
+// when they detect that the list was structurally modified in a way they did
+// not expect, for example when a sub-list is used after elements were added
+// to or removed from its backing list directly, or when elements are added or
+// removed other than through the iterator while the list is being iterated,
+// ranged over with All or Backward, or passed to the predicate of RemoveIf or
+// the compare function of Sort. The check is best-effort and meant to catch
+// bugs; it is not a substitute for synchronization.
+var ErrConcurrentModification = errors.New("list: concurrent modification")
+
 // List represents a list of elements.
 type List[T any] interface {
 	base.Collection[T]
@@ -11,10 +25,40 @@ type List[T any] interface {
 	// Add adds the specified element to the list.
 	Add(element T)
 
+	// AddAt inserts the specified element at the specified position in the
+	// list, shifting the element at that position and any subsequent elements
+	// to the right. Returns false if the index is out of range (index < 0 ||
+	// index > Size()).
+	AddAt(index int, element T) bool
+
+	// AddAll appends the specified elements to the end of the list, in order.
+	AddAll(elements ...T)
+
+	// AddAllAt inserts the specified elements, in order, at the specified
+	// position in the list, shifting the element at that position and any
+	// subsequent elements to the right. Returns false if the index is out of
+	// range (index < 0 || index > Size()).
+	AddAllAt(index int, elements ...T) bool
+
 	// Remove removes the first occurrence of the specified element from the
 	// list. Returns true if the element is removed, false otherwise.
 	Remove(element T) bool
 
+	// RemoveAt removes the element at the specified position in the list,
+	// shifting any subsequent elements to the left. Returns the removed
+	// element and true, or nil and false if the index is out of range (index
+	// < 0 || index >= Size()).
+	RemoveAt(index int) (*T, bool)
+
+	// RemoveRange removes the elements whose index is between from, inclusive,
+	// and to, exclusive. Returns false if the range is invalid (from < 0 || to
+	// > Size() || from > to).
+	RemoveRange(from, to int) bool
+
+	// RemoveIf removes all the elements of the list that satisfy the given
+	// predicate. Returns true if any element is removed.
+	RemoveIf(predicate func(element T) bool) bool
+
 	// Set replaces the element at the specified position in the list with the
 	// specified element.
 	Set(index int, element T) (*T, bool)
@@ -33,6 +77,81 @@ type List[T any] interface {
 	// element in the list. If the list does not contain the element, returns
 	// -1 and false.
 	LastIndexOf(element T) (int, bool)
+
+	// Backward returns an iterator over the index-element pairs of the list,
+	// from the last element to the first.
+	Backward() iter.Seq2[int, T]
+
+	// Sort sorts the list in ascending order as determined by the compare
+	// function, which returns a negative number when a < b, a positive number
+	// when a > b and zero when a == b. The sort is not guaranteed to be
+	// stable.
+	Sort(compare func(a, b T) int)
+
+	// SortStable sorts the list like Sort, while keeping the original order
+	// of equal elements.
+	SortStable(compare func(a, b T) int)
+
+	// SubList returns a view of the portion of the list between from,
+	// inclusive, and to, exclusive. Returns nil and false if the range is
+	// invalid (from < 0 || to > Size() || from > to).
+	//
+	// The view is backed by the list: changes made through the view are
+	// reflected in the list, and non-structural changes made to the list,
+	// such as Set, are reflected in the view. If the list is structurally
+	// modified other than through the view, for example by adding or
+	// removing elements, any later use of the view panics with
+	// ErrConcurrentModification.
+	SubList(from, to int) (List[T], bool)
+
+	// Equals returns true if other is a List of the same size whose elements
+	// are equal, in order, to the elements of this list, as determined by the
+	// equality of this list. The implementation of other does not matter, so
+	// an ArrayList and a LinkedList holding the same sequence are equal.
+	Equals(other base.Collection[T]) bool
+
+	// Hash returns a hash of the elements of the list, in order, computed with
+	// hash/maphash and the given seed from the hashes of the elements. Lists
+	// that are Equals and hash their elements alike, such as lists created
+	// with the same constructor, have the same hash for the same seed.
+	Hash(seed maphash.Seed) uint64
+}
+
+// ArrayList is a List backed by a Go slice.
+type ArrayList[T any] interface {
+	List[T]
+
+	// BinarySearch searches for the element in the list, which must be sorted
+	// in ascending order as determined by the compare function. Returns the
+	// index where the element is found, or where it would be inserted to keep
+	// the list sorted, and whether it is found.
+	//
+	// The operation is performed in O(log n) time.
+	BinarySearch(element T, compare func(a, b T) int) (int, bool)
+
+	// Cap returns the number of elements the list can hold before it has to
+	// grow its backing array.
+	Cap() int
+
+	// EnsureCapacity grows the backing array, if necessary, so that at least
+	// n more elements can be added without another allocation. It panics if
+	// n is negative.
+	//
+	// The operation is performed in O(n) time if the list grows, and O(1)
+	// time otherwise.
+	EnsureCapacity(n int)
+
+	// TrimToSize shrinks the backing array to the size of the list, releasing
+	// any unused capacity.
+	//
+	// The operation is performed in O(n) time.
+	TrimToSize()
+
+	// ClearRetain removes all the elements from the list but keeps its
+	// backing array, so that the list can be refilled without allocating.
+	//
+	// The operation is performed in O(n) time.
+	ClearRetain()
 }
 
 // LinkedList is a doubly-linked
@@ -61,6 +180,12 @@ type LinkedList[T any] interface {
 	// The operation is performed in O(n) time.
 	Reverse()
 
+	// Sort sorts the list using a merge sort that relinks the existing nodes
+	// instead of copying the elements. The sort is stable.
+	//
+	// The operation is performed in O(n log n) time.
+	Sort(compare func(a, b T) int)
+
 	// Remove removes the first occurrence of the specified element from the
 	// list. Returns true if the element is removed, false otherwise.
 	//
@@ -75,6 +200,22 @@ type LinkedList[T any] interface {
 	// The operation is performed in O(1) time.
 	RemoveFirst() (*T, bool)
 
+	// AddAt inserts the specified element at the specified position in the
+	// list. Returns false if the index is out of range (index < 0 || index >
+	// Size()).
+	//
+	// The operation is performed in O(n) time in the worst case, walking from
+	// whichever end of the list is nearer to the index.
+	AddAt(index int, element T) bool
+
+	// RemoveAt removes the element at the specified position in the list.
+	// Returns the removed element and true, or nil and false if the index is
+	// out of range (index < 0 || index >= Size()).
+	//
+	// The operation is performed in O(n) time in the worst case, walking from
+	// whichever end of the list is nearer to the index.
+	RemoveAt(index int) (*T, bool)
+
 	// RemoveLast removes and returns the last element from the list. Returns
 	// the removed element and true if the list is not empty, nil and false
 	// otherwise.
@@ -98,7 +239,8 @@ type LinkedList[T any] interface {
 	// index is out of range (index < 0 || index >= Size()), returns nil and
 	// false.
 	//
-	// The operation is performed in O(n) time in the worst case.
+	// The operation is performed in O(n) time in the worst case, walking from
+	// whichever end of the list is nearer to the index.
 	Get(index int) (*T, bool)
 
 	// IndexOf returns the index of the first occurrence of the specified
@@ -114,4 +256,71 @@ type LinkedList[T any] interface {
 	//
 	// The operation is performed in O(n) time in the worst case.
 	LastIndexOf(element T) (int, bool)
+
+	// Front returns the handle of the first element of the list, or nil if
+	// the list is empty.
+	//
+	// The operation is performed in O(1) time.
+	Front() *Element[T]
+
+	// Back returns the handle of the last element of the list, or nil if the
+	// list is empty.
+	//
+	// The operation is performed in O(1) time.
+	Back() *Element[T]
+
+	// PushFront inserts the specified element at the beginning of the list and
+	// returns its handle.
+	//
+	// The operation is performed in O(1) time.
+	PushFront(element T) *Element[T]
+
+	// PushBack appends the specified element to the end of the list and
+	// returns its handle.
+	//
+	// The operation is performed in O(1) time.
+	PushBack(element T) *Element[T]
+
+	// InsertBefore inserts the specified element immediately before mark and
+	// returns its handle. Returns nil if mark is not an element of the list.
+	//
+	// The operation is performed in O(1) time.
+	InsertBefore(element T, mark *Element[T]) *Element[T]
+
+	// InsertAfter inserts the specified element immediately after mark and
+	// returns its handle. Returns nil if mark is not an element of the list.
+	//
+	// The operation is performed in O(1) time.
+	InsertAfter(element T, mark *Element[T]) *Element[T]
+
+	// MoveToFront moves e to the beginning of the list. Returns false if e is
+	// not an element of the list.
+	//
+	// The operation is performed in O(1) time.
+	MoveToFront(e *Element[T]) bool
+
+	// MoveToBack moves e to the end of the list. Returns false if e is not an
+	// element of the list.
+	//
+	// The operation is performed in O(1) time.
+	MoveToBack(e *Element[T]) bool
+
+	// MoveBefore moves e immediately before mark. Returns false if e or mark
+	// is not an element of the list, or if they are the same element.
+	//
+	// The operation is performed in O(1) time.
+	MoveBefore(e, mark *Element[T]) bool
+
+	// MoveAfter moves e immediately after mark. Returns false if e or mark is
+	// not an element of the list, or if they are the same element.
+	//
+	// The operation is performed in O(1) time.
+	MoveAfter(e, mark *Element[T]) bool
+
+	// RemoveElement removes e from the list. Returns false if e is not an
+	// element of the list, for example because it was already removed. The
+	// value of a removed element stays readable through its handle.
+	//
+	// The operation is performed in O(1) time.
+	RemoveElement(e *Element[T]) bool
 }
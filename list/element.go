@@ -0,0 +1,118 @@
+package list
+
+// Element is a handle to an element of a LinkedList, returned by the methods
+// of the list that insert elements, such as PushBack. It can be used to walk
+// the list from that element, or to move or remove it in O(1) time, and it
+// distinguishes duplicates that an equality-based method such as Remove
+// cannot.
+type Element[T any] struct {
+	// Value is the element stored in the list. Assigning to it replaces the
+	// element in place.
+	Value T
+
+	prev *Element[T]
+	next *Element[T]
+
+	// list is the list the element belongs to, or nil once it is removed.
+	list *linkedList[T]
+}
+
+// Next returns the next element of the list, or nil if e is the last element
+// or has been removed from its list.
+func (e *Element[T]) Next() *Element[T] {
+	if e.list == nil {
+		return nil
+	}
+	return e.next
+}
+
+// Prev returns the previous element of the list, or nil if e is the first
+// element or has been removed from its list.
+func (e *Element[T]) Prev() *Element[T] {
+	if e.list == nil {
+		return nil
+	}
+	return e.prev
+}
+
+func (l *linkedList[T]) Front() *Element[T] {
+	return l.head
+}
+
+func (l *linkedList[T]) Back() *Element[T] {
+	return l.tail
+}
+
+func (l *linkedList[T]) PushFront(element T) *Element[T] {
+	return l.link(&Element[T]{Value: element}, nil, l.head)
+}
+
+func (l *linkedList[T]) PushBack(element T) *Element[T] {
+	return l.link(&Element[T]{Value: element}, l.tail, nil)
+}
+
+func (l *linkedList[T]) InsertBefore(element T, mark *Element[T]) *Element[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	return l.link(&Element[T]{Value: element}, mark.prev, mark)
+}
+
+func (l *linkedList[T]) InsertAfter(element T, mark *Element[T]) *Element[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	return l.link(&Element[T]{Value: element}, mark, mark.next)
+}
+
+func (l *linkedList[T]) MoveToFront(e *Element[T]) bool {
+	if e == nil || e.list != l {
+		return false
+	}
+	if l.head != e {
+		l.unlink(e)
+		l.link(e, nil, l.head)
+	}
+	return true
+}
+
+func (l *linkedList[T]) MoveToBack(e *Element[T]) bool {
+	if e == nil || e.list != l {
+		return false
+	}
+	if l.tail != e {
+		l.unlink(e)
+		l.link(e, l.tail, nil)
+	}
+	return true
+}
+
+func (l *linkedList[T]) MoveBefore(e, mark *Element[T]) bool {
+	if e == nil || mark == nil || e.list != l || mark.list != l || e == mark {
+		return false
+	}
+	if e.next != mark {
+		l.unlink(e)
+		l.link(e, mark.prev, mark)
+	}
+	return true
+}
+
+func (l *linkedList[T]) MoveAfter(e, mark *Element[T]) bool {
+	if e == nil || mark == nil || e.list != l || mark.list != l || e == mark {
+		return false
+	}
+	if e.prev != mark {
+		l.unlink(e)
+		l.link(e, mark, mark.next)
+	}
+	return true
+}
+
+func (l *linkedList[T]) RemoveElement(e *Element[T]) bool {
+	if e == nil || e.list != l {
+		return false
+	}
+	l.unlink(e)
+	return true
+}
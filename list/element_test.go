@@ -0,0 +1,150 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+// elementValues walks the list through its element handles, forwards and
+// backwards, and fails the test unless both walks agree.
+func elementValues[T any](t *testing.T, l LinkedList[T]) []T {
+	t.Helper()
+	var forward, backward []T
+	for e := l.Front(); e != nil; e = e.Next() {
+		forward = append(forward, e.Value)
+	}
+	for e := l.Back(); e != nil; e = e.Prev() {
+		backward = append(backward, e.Value)
+	}
+	slices.Reverse(backward)
+	if len(forward) != l.Size() || len(backward) != l.Size() {
+		t.Fatalf("Expected to walk %d elements, got %d forwards and %d backwards", l.Size(), len(forward), len(backward))
+	}
+	return forward
+}
+
+func TestLinkedList_PushAndInsert(t *testing.T) {
+	l := NewLinkedList[int]()
+	if l.Front() != nil || l.Back() != nil {
+		t.Fatalf("Expected an empty list to have no front or back")
+	}
+
+	two := l.PushBack(2)
+	l.PushFront(0)
+	four := l.PushBack(4)
+	l.InsertBefore(1, two)
+	l.InsertAfter(3, two)
+	l.InsertAfter(5, four)
+
+	if got := elementValues(t, l); !slices.Equal(got, []int{0, 1, 2, 3, 4, 5}) {
+		t.Fatalf("Expected [0 1 2 3 4 5], got %v", got)
+	}
+	if l.Front().Value != 0 || l.Back().Value != 5 {
+		t.Fatalf("Expected front 0 and back 5, got %v and %v", l.Front().Value, l.Back().Value)
+	}
+
+	other := NewLinkedList[int]()
+	foreign := other.PushBack(9)
+	if l.InsertBefore(7, foreign) != nil || l.InsertAfter(7, nil) != nil {
+		t.Fatalf("Expected inserting next to an element of another list to fail")
+	}
+}
+
+func TestLinkedList_Move(t *testing.T) {
+	l := NewLinkedList[string]()
+	a := l.PushBack("a")
+	b := l.PushBack("b")
+	c := l.PushBack("c")
+	d := l.PushBack("d")
+
+	scenarios := []struct {
+		name     string
+		move     func() bool
+		expected []string
+	}{
+		{name: "MoveToFront", move: func() bool { return l.MoveToFront(c) }, expected: []string{"c", "a", "b", "d"}},
+		{name: "MoveToFront of the front", move: func() bool { return l.MoveToFront(c) }, expected: []string{"c", "a", "b", "d"}},
+		{name: "MoveToBack", move: func() bool { return l.MoveToBack(a) }, expected: []string{"c", "b", "d", "a"}},
+		{name: "MoveBefore", move: func() bool { return l.MoveBefore(d, c) }, expected: []string{"d", "c", "b", "a"}},
+		{name: "MoveAfter", move: func() bool { return l.MoveAfter(d, a) }, expected: []string{"c", "b", "a", "d"}},
+		{name: "MoveAfter the previous element", move: func() bool { return l.MoveAfter(d, a) }, expected: []string{"c", "b", "a", "d"}},
+		{name: "MoveBefore the next element", move: func() bool { return l.MoveBefore(b, a) }, expected: []string{"c", "b", "a", "d"}},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			if !s.move() {
+				t.Fatalf("Expected the move to succeed")
+			}
+			if got := elementValues(t, l); !slices.Equal(got, s.expected) {
+				t.Fatalf("Expected %v, got %v", s.expected, got)
+			}
+		})
+	}
+
+	if l.MoveBefore(a, a) || l.MoveAfter(a, nil) || l.MoveToFront(nil) {
+		t.Fatalf("Expected moving an element relative to itself or nil to fail")
+	}
+	if l.Size() != 4 {
+		t.Fatalf("Expected moves to keep the size at 4, got %d", l.Size())
+	}
+}
+
+func TestLinkedList_RemoveElement(t *testing.T) {
+	l := NewComparableLinkedList[int]()
+	first := l.PushBack(1)
+	l.PushBack(2)
+	second := l.PushBack(1)
+
+	if !l.RemoveElement(second) {
+		t.Fatalf("Expected RemoveElement to remove the second 1")
+	}
+	if got := elementValues(t, l); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("Expected the duplicate to be removed, leaving [1 2], got %v", got)
+	}
+	if l.RemoveElement(second) {
+		t.Fatalf("Expected removing an element twice to fail")
+	}
+	if second.Value != 1 || second.Next() != nil || second.Prev() != nil {
+		t.Fatalf("Expected a removed element to keep its value and have no neighbours")
+	}
+	if l.MoveToFront(second) || l.InsertAfter(3, second) != nil {
+		t.Fatalf("Expected a removed element to no longer belong to the list")
+	}
+
+	first.Value = 10
+	if got := l.Values(); !slices.Equal(got, []int{10, 2}) {
+		t.Fatalf("Expected assigning to Value to replace the element, got %v", got)
+	}
+
+	l.Clear()
+	if l.RemoveElement(first) || first.Next() != nil {
+		t.Fatalf("Expected Clear to detach every element")
+	}
+}
+
+func TestLinkedList_ElementsAfterSortAndReverse(t *testing.T) {
+	l := NewLinkedList[int]()
+	three := l.PushBack(3)
+	l.PushBack(1)
+	l.PushBack(2)
+
+	l.Sort(func(a, b int) int { return a - b })
+	if three.Next() != nil || three.Prev().Value != 2 {
+		t.Fatalf("Expected handles to follow their elements when the list is sorted")
+	}
+	l.Reverse()
+	if l.Front() != three || three.Next().Value != 2 {
+		t.Fatalf("Expected handles to follow their elements when the list is reversed")
+	}
+}
+
+func TestLinkedList_ElementConcurrentModification(t *testing.T) {
+	l := NewLinkedList[int]()
+	first := l.PushBack(1)
+	l.PushBack(2)
+	it := l.Iterator()
+	it.Next()
+	l.MoveToBack(first)
+	expectConcurrentModification(t, func() { it.Next() })
+}
@@ -0,0 +1,77 @@
+package list
+
+import (
+	"cmp"
+	"hash/maphash"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/hashing"
+)
+
+func (a *arrayList[T]) Equals(other base.Collection[T]) bool {
+	return equals[T](a, other, a.equal)
+}
+
+func (a *arrayList[T]) Hash(seed maphash.Seed) uint64 {
+	return hashing.Ordered(seed, a.All(), a.hash)
+}
+
+func (l *linkedList[T]) Equals(other base.Collection[T]) bool {
+	return equals[T](l, other, l.equal)
+}
+
+func (l *linkedList[T]) Hash(seed maphash.Seed) uint64 {
+	return hashing.Ordered(seed, l.All(), l.hash)
+}
+
+func (s *subList[T]) Equals(other base.Collection[T]) bool {
+	return equals[T](s, other, s.equal)
+}
+
+func (s *subList[T]) Hash(seed maphash.Seed) uint64 {
+	return hashing.Ordered(seed, s.All(), s.hash)
+}
+
+// equals reports whether other is a List holding the same elements as l, in
+// the same order, according to equal.
+func equals[T any](l List[T], other base.Collection[T], equal func(a, b T) bool) bool {
+	o, ok := other.(List[T])
+	if !ok || l.Size() != o.Size() {
+		return false
+	}
+	return CompareFunc(l, o, func(a, b T) int {
+		if equal(a, b) {
+			return 0
+		}
+		return 1
+	}) == 0
+}
+
+// Compare compares the elements of a and b lexicographically, using
+// cmp.Compare on each pair of elements in turn. The first pair that is not
+// equal determines the result; if one list is a prefix of the other, the
+// shorter list is less. Returns a negative number when a < b, a positive
+// number when a > b and zero when a and b are equal.
+func Compare[T cmp.Ordered](a, b List[T]) int {
+	return CompareFunc(a, b, cmp.Compare[T])
+}
+
+// CompareFunc is like Compare but uses the given compare function on each
+// pair of elements.
+func CompareFunc[T any](a, b List[T], compare func(a, b T) int) int {
+	ai, bi := a.Iterator(), b.Iterator()
+	for ai.HasNext() && bi.HasNext() {
+		x, _ := ai.Next()
+		y, _ := bi.Next()
+		if c := compare(*x, *y); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case ai.HasNext():
+		return 1
+	case bi.HasNext():
+		return -1
+	}
+	return 0
+}
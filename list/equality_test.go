@@ -0,0 +1,144 @@
+package list
+
+import (
+	"hash/maphash"
+	"math"
+	"testing"
+
+	"github.com/elias8/go-gather/ring"
+)
+
+func TestList_Equals(t *testing.T) {
+	arrayList := NewArrayList[int]()
+	arrayList.AddAll(1, 2, 3)
+	linkedList := NewLinkedList[int]()
+	linkedList.AddAll(1, 2, 3)
+	sub, _ := NewArrayListFrom([]int{0, 1, 2, 3, 4}).SubList(1, 4)
+
+	scenarios := []struct {
+		name     string
+		a        List[int]
+		b        List[int]
+		expected bool
+	}{
+		{name: "ArrayList and LinkedList", a: arrayList, b: linkedList, expected: true},
+		{name: "LinkedList and ArrayList", a: linkedList, b: arrayList, expected: true},
+		{name: "SubList and ArrayList", a: sub, b: arrayList, expected: true},
+		{name: "Unmodifiable and LinkedList", a: Unmodifiable[int](arrayList), b: linkedList, expected: true},
+		{name: "different order", a: arrayList, b: NewArrayListFrom([]int{3, 2, 1}), expected: false},
+		{name: "prefix", a: arrayList, b: NewArrayListFrom([]int{1, 2}), expected: false},
+		{name: "empty lists", a: NewArrayList[int](), b: NewLinkedList[int](), expected: true},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			if got := s.a.Equals(s.b); got != s.expected {
+				t.Fatalf("Expected Equals to return %v, got %v", s.expected, got)
+			}
+		})
+	}
+
+	t.Run("not a list", func(t *testing.T) {
+		other := ring.New[int](3, ring.Reject)
+		other.PushBack(1)
+		other.PushBack(2)
+		other.PushBack(3)
+		if arrayList.Equals(other) {
+			t.Fatalf("Expected a list not to equal a buffer with the same elements")
+		}
+	})
+
+	t.Run("custom equality", func(t *testing.T) {
+		a := NewArrayListFunc(func(a, b float64) bool { return int(a) == int(b) }, nil)
+		a.AddAll(1.2, 2.5)
+		b := NewLinkedList[float64]()
+		b.AddAll(1.9, 2.1)
+		if !a.Equals(b) {
+			t.Fatalf("Expected Equals to use the equality of the list")
+		}
+	})
+}
+
+func TestList_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	arrayList := NewArrayListFrom([]string{"a", "b", "c"})
+	linkedList := NewLinkedList[string]()
+	linkedList.AddAll("a", "b", "c")
+
+	if arrayList.Hash(seed) != linkedList.Hash(seed) {
+		t.Fatalf("Expected equal lists to have the same hash")
+	}
+	if arrayList.Hash(seed) == NewArrayListFrom([]string{"c", "b", "a"}).Hash(seed) {
+		t.Fatalf("Expected lists in a different order to have different hashes")
+	}
+
+	byHash := map[uint64]List[string]{arrayList.Hash(seed): arrayList}
+	if _, found := byHash[linkedList.Hash(seed)]; !found {
+		t.Fatalf("Expected to find an equal list by its hash")
+	}
+
+	t.Run("custom equality", func(t *testing.T) {
+		equal := func(a, b float64) bool { return int(a) == int(b) }
+		hash := func(seed maphash.Seed, f float64) uint64 { return maphash.Comparable(seed, int(f)) }
+		a, b := NewArrayListFunc(equal, hash), NewLinkedListFunc(equal, hash)
+		a.AddAll(1.2, 2.5)
+		b.AddAll(1.9, 2.1)
+		if !a.Equals(b) || a.Hash(seed) != b.Hash(seed) {
+			t.Fatalf("Expected lists equal by the custom equality to have the same hash")
+		}
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		x, y := 1, 1
+		a, b := NewArrayList[*int](), NewArrayList[*int]()
+		a.Add(&x)
+		b.Add(&y)
+		if !a.Equals(b) || a.Hash(seed) != b.Hash(seed) {
+			t.Fatalf("Expected lists of pointers to equal values to have the same hash")
+		}
+	})
+
+	t.Run("signed zeros", func(t *testing.T) {
+		a, b := NewComparableArrayList[float64](), NewComparableArrayList[float64]()
+		a.Add(0)
+		b.Add(math.Copysign(0, -1))
+		if !a.Equals(b) || a.Hash(seed) != b.Hash(seed) {
+			t.Fatalf("Expected lists of 0.0 and -0.0 to have the same hash")
+		}
+	})
+}
+
+func TestCompare(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		a        []int
+		b        []int
+		expected int
+	}{
+		{name: "equal", a: []int{1, 2, 3}, b: []int{1, 2, 3}, expected: 0},
+		{name: "less", a: []int{1, 2, 3}, b: []int{1, 3}, expected: -1},
+		{name: "greater", a: []int{2}, b: []int{1, 9, 9}, expected: 1},
+		{name: "prefix is less", a: []int{1, 2}, b: []int{1, 2, 3}, expected: -1},
+		{name: "longer is greater", a: []int{1, 2, 3}, b: []int{1, 2}, expected: 1},
+		{name: "empty lists", a: nil, b: nil, expected: 0},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			b := NewLinkedList[int]()
+			b.AddAll(s.b...)
+			if got := Compare(NewArrayListFrom(s.a), b); got != s.expected {
+				t.Fatalf("Expected Compare to return %d, got %d", s.expected, got)
+			}
+		})
+	}
+}
+
+func TestCompareFunc(t *testing.T) {
+	a := NewArrayListFrom([]string{"b", "a"})
+	b := NewArrayListFrom([]string{"B", "C"})
+	byLength := func(x, y string) int { return len(x) - len(y) }
+	if got := CompareFunc(a, b, byLength); got != 0 {
+		t.Fatalf("Expected lists to compare equal by length, got %d", got)
+	}
+}
@@ -2,29 +2,48 @@ package list
 
 import (
 	"fmt"
-	"reflect"
+	"hash/maphash"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
 )
 
-type node[T any] struct {
-	value T
-	prev  *node[T]
-	next  *node[T]
+// LinkedList represents a doubly linked list.
+type linkedList[T any] struct {
+	head     *Element[T]
+	tail     *Element[T]
+	size     int
+	modCount int
+	equal    func(a, b T) bool
+	hash     func(seed maphash.Seed, v T) uint64
 }
 
-func newNode[T any](value T) *node[T] {
-	return &node[T]{value: value}
+// NewLinkedList returns an empty LinkedList. Elements are compared using
+// reflect.DeepEqual and hashed using base.DeepHash.
+func NewLinkedList[T any]() LinkedList[T] {
+	return NewLinkedListFunc(base.DeepEqual[T], base.DeepHash[T])
 }
 
-// LinkedList represents a doubly linked list.
-type linkedList[T any] struct {
-	head *node[T]
-	tail *node[T]
-	size int
+// NewLinkedListFunc returns an empty LinkedList that compares elements using
+// the given equal function and hashes them using the given hash function,
+// which must return the same hash for elements that equal reports equal. If
+// equal is nil, reflect.DeepEqual is used, and if hash is nil, base.DeepHash
+// is used.
+func NewLinkedListFunc[T any](equal func(a, b T) bool, hash func(seed maphash.Seed, v T) uint64) LinkedList[T] {
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	if hash == nil {
+		hash = base.DeepHash[T]
+	}
+	return &linkedList[T]{equal: equal, hash: hash}
 }
 
-// NewLinkedList returns an empty LinkedList.
-func NewLinkedList[T any]() LinkedList[T] {
-	return &linkedList[T]{}
+// NewComparableLinkedList returns an empty LinkedList that compares elements
+// using the == operator and hashes them using maphash.Comparable.
+func NewComparableLinkedList[T comparable]() LinkedList[T] {
+	return NewLinkedListFunc(base.Equal[T], base.Hash[T])
 }
 
 func (l *linkedList[T]) Size() int {
@@ -37,7 +56,7 @@ func (l *linkedList[T]) IsEmpty() bool {
 
 func (l *linkedList[T]) Contains(element T) bool {
 	for current := l.head; current != nil; current = current.next {
-		if reflect.DeepEqual(current.value, element) {
+		if l.equal(current.Value, element) {
 			return true
 		}
 	}
@@ -50,7 +69,7 @@ func (l *linkedList[T]) String() string {
 		if current.prev != nil {
 			s += " <-> "
 		}
-		s += fmt.Sprintf("%v", current.value)
+		s += fmt.Sprintf("%v", current.Value)
 	}
 	s += "])"
 	return s
@@ -59,147 +78,168 @@ func (l *linkedList[T]) String() string {
 func (l *linkedList[T]) Values() []T {
 	var slice []T
 	for current := l.head; current != nil; current = current.next {
-		slice = append(slice, current.value)
+		slice = append(slice, current.Value)
 	}
 	return slice
 }
 
 func (l *linkedList[T]) Add(element T) {
-	node := newNode(element)
-	if l.head == nil {
-		l.head = node
-		l.tail = node
-	} else {
-		prev := l.tail
-		l.tail = node
-		prev.next = node
-		node.prev = prev
-	}
-	l.size++
+	l.PushBack(element)
 }
 
 func (l *linkedList[T]) AddFirst(element T) {
-	node := newNode(element)
-	if l.head == nil {
-		l.head = node
-		l.tail = node
-	} else {
-		l.head.prev = node
-		node.next = l.head
-		l.head = node
-	}
-	l.size++
+	l.PushFront(element)
 }
 
 func (l *linkedList[T]) AddLast(element T) {
 	l.Add(element)
 }
 
+func (l *linkedList[T]) AddAt(index int, element T) bool {
+	return l.AddAllAt(index, element)
+}
+
+func (l *linkedList[T]) AddAll(elements ...T) {
+	for _, e := range elements {
+		l.Add(e)
+	}
+}
+
+func (l *linkedList[T]) AddAllAt(index int, elements ...T) bool {
+	if index < 0 || index > l.size {
+		return false
+	}
+	if index == l.size {
+		l.AddAll(elements...)
+		return true
+	}
+	next := l.nodeAt(index)
+	for _, e := range elements {
+		l.link(&Element[T]{Value: e}, next.prev, next)
+	}
+	return true
+}
+
+// Clear detaches every element so that their handles no longer refer to the
+// list, which takes O(n) time.
 func (l *linkedList[T]) Clear() {
+	for current := l.head; current != nil; {
+		next := current.next
+		current.prev = nil
+		current.next = nil
+		current.list = nil
+		current = next
+	}
 	l.head = nil
 	l.tail = nil
 	l.size = 0
+	l.modCount++
 }
 
 func (l *linkedList[T]) Remove(element T) bool {
-	current := l.head
-	for current != nil {
-		if reflect.DeepEqual(current.value, element) {
-			if current.prev != nil {
-				current.prev.next = current.next
-			} else {
-				l.head = current.next
-			}
-			if current.next != nil {
-				current.next.prev = current.prev
-			} else {
-				l.tail = current.prev
-			}
-			l.size--
+	for current := l.head; current != nil; current = current.next {
+		if l.equal(current.Value, element) {
+			l.unlink(current)
 			return true
 		}
-		current = current.next
 	}
 	return false
 }
 
-func (l *linkedList[T]) RemoveFirst() (*T, bool) {
-	if l.head != nil {
-		temp := l.head
-		l.head = l.head.next
-		if l.head != nil {
-			l.head.prev = nil
+func (l *linkedList[T]) RemoveAt(index int) (*T, bool) {
+	current := l.nodeAt(index)
+	if current == nil {
+		return nil, false
+	}
+	l.unlink(current)
+	return &current.Value, true
+}
+
+func (l *linkedList[T]) RemoveRange(from, to int) bool {
+	if from < 0 || to > l.size || from > to {
+		return false
+	}
+	current := l.nodeAt(from)
+	for i := from; i < to; i++ {
+		next := current.next
+		l.unlink(current)
+		current = next
+	}
+	return true
+}
+
+func (l *linkedList[T]) RemoveIf(predicate func(element T) bool) bool {
+	removed := false
+	modCount := l.modCount
+	for current := l.head; current != nil; {
+		next := current.next
+		remove := predicate(current.Value)
+		checkModifications(l, modCount)
+		if remove {
+			l.unlink(current)
+			modCount = l.modCount
+			removed = true
 		}
-		l.size--
-		return &temp.value, true
+		current = next
 	}
-	return nil, false
+	return removed
 }
 
-func (l *linkedList[T]) RemoveLast() (*T, bool) {
+func (l *linkedList[T]) RemoveFirst() (*T, bool) {
 	if l.head == nil {
 		return nil, false
-	} else if l.head == l.tail {
-		removed := l.tail.value
-		l.head = nil
-		l.tail = nil
-		l.size--
-		return &removed, true
-	} else {
-		removed := l.tail.value
-		l.tail = l.tail.prev
-		l.tail.next = nil
-		l.size--
-		return &removed, true
 	}
+	removed := l.head
+	l.unlink(removed)
+	return &removed.Value, true
+}
+
+func (l *linkedList[T]) RemoveLast() (*T, bool) {
+	if l.tail == nil {
+		return nil, false
+	}
+	removed := l.tail
+	l.unlink(removed)
+	return &removed.Value, true
 }
 
 func (l *linkedList[T]) Set(index int, element T) (*T, bool) {
-	position := 0
-	current := l.head
-	for current != nil && position <= l.size && position <= index {
-		if position == index {
-			replaced := current.value
-			current.value = element
-			return &replaced, true
-		}
-		current = current.next
-		position++
+	current := l.nodeAt(index)
+	if current == nil {
+		return nil, false
 	}
-	return nil, false
+	replaced := current.Value
+	current.Value = element
+	return &replaced, true
 }
 
 func (l *linkedList[T]) Get(index int) (*T, bool) {
-	position := 0
-	current := l.head
-	for current != nil && position <= l.size && position <= index {
-		if position == index {
-			return &current.value, true
-		}
-		current = current.next
+	current := l.nodeAt(index)
+	if current == nil {
+		return nil, false
 	}
-	return nil, false
+	return &current.Value, true
 }
 
 func (l *linkedList[T]) GetFirst() (*T, bool) {
 	if l.head == nil {
 		return nil, false
 	}
-	return &l.head.value, true
+	return &l.head.Value, true
 }
 
 func (l *linkedList[T]) GetLast() (*T, bool) {
 	if l.tail == nil {
 		return nil, false
 	}
-	return &l.tail.value, true
+	return &l.tail.Value, true
 }
 
 func (l *linkedList[T]) IndexOf(element T) (int, bool) {
 	position := 0
 	current := l.head
 	for current != nil {
-		if reflect.DeepEqual(current.value, element) {
+		if l.equal(current.Value, element) {
 			return position, true
 		}
 		current = current.next
@@ -212,7 +252,7 @@ func (l *linkedList[T]) LastIndexOf(element T) (int, bool) {
 	position := l.size - 1
 	current := l.tail
 	for current != nil {
-		if reflect.DeepEqual(current.value, element) {
+		if l.equal(current.Value, element) {
 			return position, true
 		}
 		current = current.prev
@@ -230,4 +270,232 @@ func (l *linkedList[T]) Reverse() {
 		current = next
 	}
 	l.tail, l.head = l.head, l.tail
+	l.modCount++
+}
+
+func (l *linkedList[T]) Sort(compare func(a, b T) int) {
+	modCount := l.modCount
+	l.head = mergeSort(l.head, compare)
+	checkModifications(l, modCount)
+	var prev *Element[T]
+	for current := l.head; current != nil; current = current.next {
+		current.prev = prev
+		prev = current
+	}
+	l.tail = prev
+	l.modCount++
+}
+
+func (l *linkedList[T]) SortStable(compare func(a, b T) int) {
+	l.Sort(compare)
+}
+
+func (l *linkedList[T]) Iterator() base.Iterator[T] {
+	return &linkedListIterator[T]{list: l, next: l.head, modCount: l.modCount}
+}
+
+func (l *linkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		modCount := l.modCount
+		for current := l.head; current != nil; current = current.next {
+			if !yield(current.Value) {
+				return
+			}
+			checkModifications(l, modCount)
+		}
+	}
+}
+
+func (l *linkedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		modCount := l.modCount
+		position := l.size - 1
+		for current := l.tail; current != nil; current = current.prev {
+			if !yield(position, current.Value) {
+				return
+			}
+			checkModifications(l, modCount)
+			position--
+		}
+	}
+}
+
+// nodeAt returns the node at the given index, walking from whichever end of
+// the list is nearer. Returns nil if the index is out of range.
+func (l *linkedList[T]) nodeAt(index int) *Element[T] {
+	if index < 0 || index >= l.size {
+		return nil
+	}
+	if index < l.size/2 {
+		current := l.head
+		for i := 0; i < index; i++ {
+			current = current.next
+		}
+		return current
+	}
+	current := l.tail
+	for i := l.size - 1; i > index; i-- {
+		current = current.prev
+	}
+	return current
+}
+
+// link inserts e between prev and next, which are adjacent elements of the
+// list or nil at either end, and returns e.
+func (l *linkedList[T]) link(e, prev, next *Element[T]) *Element[T] {
+	e.list = l
+	e.prev = prev
+	e.next = next
+	if prev != nil {
+		prev.next = e
+	} else {
+		l.head = e
+	}
+	if next != nil {
+		next.prev = e
+	} else {
+		l.tail = e
+	}
+	l.size++
+	l.modCount++
+	return e
+}
+
+// unlink detaches the given node from the list.
+func (l *linkedList[T]) unlink(n *Element[T]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		l.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		l.tail = n.prev
+	}
+	n.prev = nil
+	n.next = nil
+	n.list = nil
+	l.size--
+	l.modCount++
+}
+
+// linkedListIterator is fail-fast: Next and Remove panic with
+// ErrConcurrentModification if the list was structurally modified other than
+// through the iterator after it was created.
+type linkedListIterator[T any] struct {
+	list     *linkedList[T]
+	next     *Element[T]
+	last     *Element[T]
+	modCount int
+}
+
+func (it *linkedListIterator[T]) HasNext() bool {
+	return it.next != nil
+}
+
+func (it *linkedListIterator[T]) Next() (*T, bool) {
+	if it.next == nil {
+		return nil, false
+	}
+	checkModifications(it.list, it.modCount)
+	it.last = it.next
+	it.next = it.next.next
+	return &it.last.Value, true
+}
+
+func (it *linkedListIterator[T]) Remove() bool {
+	if it.last == nil {
+		return false
+	}
+	checkModifications(it.list, it.modCount)
+	it.list.unlink(it.last)
+	it.modCount = it.list.modCount
+	it.last = nil
+	return true
+}
+
+// mergeSort sorts the nodes starting at head by their next links and returns
+// the new head. The prev links are left untouched.
+func mergeSort[T any](head *Element[T], compare func(a, b T) int) *Element[T] {
+	if head == nil || head.next == nil {
+		return head
+	}
+	slow, fast := head, head.next
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+	}
+	second := slow.next
+	slow.next = nil
+	return merge(mergeSort(head, compare), mergeSort(second, compare), compare)
+}
+
+// merge merges two sorted chains of nodes, taking from a first on ties to keep
+// the sort stable.
+func merge[T any](a, b *Element[T], compare func(a, b T) int) *Element[T] {
+	var head, tail *Element[T]
+	for a != nil && b != nil {
+		var next *Element[T]
+		if compare(b.Value, a.Value) < 0 {
+			next, b = b, b.next
+		} else {
+			next, a = a, a.next
+		}
+		if head == nil {
+			head = next
+		} else {
+			tail.next = next
+		}
+		tail = next
+	}
+	rest := a
+	if rest == nil {
+		rest = b
+	}
+	if head == nil {
+		return rest
+	}
+	tail.next = rest
+	return head
+}
+
+func (l *linkedList[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(l.Values())
+}
+
+func (l *linkedList[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	l.replace(values)
+	return nil
+}
+
+func (l *linkedList[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(l.Values())
+}
+
+func (l *linkedList[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	l.replace(values)
+	return nil
+}
+
+func (l *linkedList[T]) GobEncode() ([]byte, error) {
+	return l.MarshalBinary()
+}
+
+func (l *linkedList[T]) GobDecode(data []byte) error {
+	return l.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the list with the decoded values.
+func (l *linkedList[T]) replace(values []T) {
+	l.Clear()
+	l.AddAll(values...)
 }
@@ -1,7 +1,12 @@
 package list
 
 import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"hash/maphash"
 	"reflect"
 	"testing"
 )
@@ -65,15 +70,15 @@ func (s linkedListScenario[T]) testNodeConstruction(t *testing.T, list LinkedLis
 		if current == nil {
 			t.Fatalf("Expected current to be not nil")
 		}
-		if !reflect.DeepEqual(current.value, s.expected[i]) {
-			t.Fatalf("Expected current.value to be %v, but found %v", s.expected[i], current.value)
+		if !reflect.DeepEqual(current.Value, s.expected[i]) {
+			t.Fatalf("Expected current.Value to be %v, but found %v", s.expected[i], current.Value)
 		}
 		if i == 0 {
 			if current.prev != nil {
 				t.Fatalf("Expected head.prev to be nil, but found %v", current.prev)
 			}
 			if ll.head != current {
-				t.Fatalf("Expected head to be the first element (%v), but found %v", s.expected[i], ll.head.value)
+				t.Fatalf("Expected head to be the first element (%v), but found %v", s.expected[i], ll.head.Value)
 			}
 		} else {
 			if current.prev == nil {
@@ -88,7 +93,7 @@ func (s linkedListScenario[T]) testNodeConstruction(t *testing.T, list LinkedLis
 				t.Fatalf("Expected tail.next to be nil, but found %v", current.next)
 			}
 			if ll.tail != current {
-				t.Fatalf("Expected tail to be the last element (%v), but found %v", s.expected[i], ll.tail.value)
+				t.Fatalf("Expected tail to be the last element (%v), but found %v", s.expected[i], ll.tail.Value)
 			}
 		} else {
 			if current.next == nil {
@@ -112,6 +117,43 @@ func TestNewLinkedList(t *testing.T) {
 	}
 }
 
+func TestNewLinkedListFunc(t *testing.T) {
+	type entity struct {
+		id   int
+		name string
+	}
+	ll := NewLinkedListFunc(
+		func(a, b entity) bool { return a.id == b.id },
+		func(seed maphash.Seed, e entity) uint64 { return maphash.Comparable(seed, e.id) },
+	)
+	ll.Add(entity{id: 1, name: "first"})
+	ll.Add(entity{id: 2, name: "second"})
+	ll.Add(entity{id: 1, name: "third"})
+
+	if index, found := ll.LastIndexOf(entity{id: 1}); !found || index != 2 {
+		t.Fatalf("Expected last entity with id 1 at index 2, got %d", index)
+	}
+	if !ll.Remove(entity{id: 2, name: "renamed"}) {
+		t.Fatalf("Expected entity with id 2 to be removed")
+	}
+	if ll.Contains(entity{id: 2}) {
+		t.Fatalf("Expected entity with id 2 to not be in the list")
+	}
+}
+
+func TestNewComparableLinkedList(t *testing.T) {
+	ll := NewComparableLinkedList[string]()
+	ll.Add("a")
+	ll.Add("b")
+
+	if index, found := ll.IndexOf("b"); !found || index != 1 {
+		t.Fatalf("Expected index of b to be 1, got %d", index)
+	}
+	if ll.Contains("c") {
+		t.Fatalf("Expected list to not contain c")
+	}
+}
+
 func TestLinkedList_Add(t *testing.T) {
 	scenarios := []struct {
 		linkedListScenario[int]
@@ -884,3 +926,359 @@ func TestLinkedList_String(t *testing.T) {
 		})
 	}
 }
+
+func TestLinkedList_Iterator(t *testing.T) {
+	scenarios := []struct {
+		linkedListScenario[int]
+		remove func(int) bool
+	}{
+		{
+			linkedListScenario: linkedListScenario[int]{
+				name:     "iterate empty list",
+				values:   []int{},
+				expected: nil,
+			},
+			remove: func(int) bool { return true },
+		},
+		{
+			linkedListScenario: linkedListScenario[int]{
+				name:     "remove head while iterating",
+				values:   []int{1, 2, 3},
+				expected: []int{2, 3},
+			},
+			remove: func(v int) bool { return v == 1 },
+		},
+		{
+			linkedListScenario: linkedListScenario[int]{
+				name:     "remove middle while iterating",
+				values:   []int{1, 2, 3},
+				expected: []int{1, 3},
+			},
+			remove: func(v int) bool { return v == 2 },
+		},
+		{
+			linkedListScenario: linkedListScenario[int]{
+				name:     "remove tail while iterating",
+				values:   []int{1, 2, 3},
+				expected: []int{1, 2},
+			},
+			remove: func(v int) bool { return v == 3 },
+		},
+		{
+			linkedListScenario: linkedListScenario[int]{
+				name:     "remove all while iterating",
+				values:   []int{1, 2, 3},
+				expected: nil,
+			},
+			remove: func(int) bool { return true },
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			ll := NewLinkedList[int]()
+			s.setup(ll)
+
+			it := ll.Iterator()
+			if it.Remove() {
+				t.Fatalf("Expected Remove to fail before calling Next")
+			}
+			var visited []int
+			for it.HasNext() {
+				value, _ := it.Next()
+				visited = append(visited, *value)
+				if s.remove(*value) && !it.Remove() {
+					t.Fatalf("Expected %v to be removed", *value)
+				}
+			}
+			if len(visited) != len(s.values) {
+				t.Fatalf("Expected to visit %v, but visited %v", s.values, visited)
+			}
+			s.test(t, ll)
+		})
+	}
+}
+
+func TestLinkedList_All(t *testing.T) {
+	ll := NewLinkedList[int]()
+	for _, v := range []int{1, 2, 3} {
+		ll.Add(v)
+	}
+
+	var visited []int
+	for v := range ll.All() {
+		visited = append(visited, v)
+	}
+	if !reflect.DeepEqual(visited, []int{1, 2, 3}) {
+		t.Fatalf("Expected to visit [1 2 3], but visited %v", visited)
+	}
+}
+
+func TestLinkedList_Backward(t *testing.T) {
+	ll := NewLinkedList[int]()
+	for _, v := range []int{1, 2, 3} {
+		ll.Add(v)
+	}
+
+	var indexes, visited []int
+	for i, v := range ll.Backward() {
+		indexes = append(indexes, i)
+		visited = append(visited, v)
+		if i == 1 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(indexes, []int{2, 1}) {
+		t.Fatalf("Expected indexes [2 1], but found %v", indexes)
+	}
+	if !reflect.DeepEqual(visited, []int{3, 2}) {
+		t.Fatalf("Expected values [3 2], but found %v", visited)
+	}
+}
+
+func TestLinkedList_RemoveFirst_ClearsTail(t *testing.T) {
+	ll := NewLinkedList[int]()
+	ll.Add(1)
+	ll.RemoveFirst()
+
+	if last, ok := ll.GetLast(); ok {
+		t.Fatalf("Expected no last element after removing the only element, but found %v", *last)
+	}
+}
+
+func TestLinkedList_AddAt(t *testing.T) {
+	scenarios := []struct {
+		linkedListScenario[int]
+		index         int
+		shouldSucceed bool
+	}{
+		{linkedListScenario: linkedListScenario[int]{name: "add at empty list", values: []int{}, expected: []int{9}}, index: 0, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "add at head", values: []int{1, 2, 3}, expected: []int{9, 1, 2, 3}}, index: 0, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "add at front half", values: []int{1, 2, 3, 4}, expected: []int{1, 9, 2, 3, 4}}, index: 1, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "add at back half", values: []int{1, 2, 3, 4}, expected: []int{1, 2, 3, 9, 4}}, index: 3, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "add at tail", values: []int{1, 2, 3}, expected: []int{1, 2, 3, 9}}, index: 3, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "add at invalid index", values: []int{1, 2, 3}, expected: []int{1, 2, 3}}, index: 4, shouldSucceed: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			ll := NewLinkedList[int]()
+			s.setup(ll)
+			if success := ll.AddAt(s.index, 9); success != s.shouldSucceed {
+				t.Fatalf("Expected AddAt(%d) to return %v", s.index, s.shouldSucceed)
+			}
+			s.test(t, ll)
+		})
+	}
+}
+
+func TestLinkedList_AddAllAt(t *testing.T) {
+	scenarios := []struct {
+		linkedListScenario[int]
+		index         int
+		shouldSucceed bool
+	}{
+		{linkedListScenario: linkedListScenario[int]{name: "add all at empty list", values: []int{}, expected: []int{8, 9}}, index: 0, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "add all at head", values: []int{1, 2}, expected: []int{8, 9, 1, 2}}, index: 0, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "add all at middle", values: []int{1, 2}, expected: []int{1, 8, 9, 2}}, index: 1, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "add all at tail", values: []int{1, 2}, expected: []int{1, 2, 8, 9}}, index: 2, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "add all at negative index", values: []int{1, 2}, expected: []int{1, 2}}, index: -1, shouldSucceed: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			ll := NewLinkedList[int]()
+			s.setup(ll)
+			if success := ll.AddAllAt(s.index, 8, 9); success != s.shouldSucceed {
+				t.Fatalf("Expected AddAllAt(%d) to return %v", s.index, s.shouldSucceed)
+			}
+			s.test(t, ll)
+		})
+	}
+}
+
+func TestLinkedList_AddAll(t *testing.T) {
+	s := linkedListScenario[int]{values: []int{1}, expected: []int{1, 2, 3}}
+	ll := NewLinkedList[int]()
+	s.setup(ll)
+	ll.AddAll(2, 3)
+	s.test(t, ll)
+}
+
+func TestLinkedList_RemoveAt(t *testing.T) {
+	scenarios := []struct {
+		linkedListScenario[int]
+		index      int
+		shouldFind bool
+	}{
+		{linkedListScenario: linkedListScenario[int]{name: "remove at empty list", values: []int{}, expected: nil}, index: 0, shouldFind: false},
+		{linkedListScenario: linkedListScenario[int]{name: "remove at only element", values: []int{1}, expected: nil}, index: 0, shouldFind: true},
+		{linkedListScenario: linkedListScenario[int]{name: "remove at head", values: []int{1, 2, 3, 4}, expected: []int{2, 3, 4}}, index: 0, shouldFind: true},
+		{linkedListScenario: linkedListScenario[int]{name: "remove at front half", values: []int{1, 2, 3, 4}, expected: []int{1, 3, 4}}, index: 1, shouldFind: true},
+		{linkedListScenario: linkedListScenario[int]{name: "remove at back half", values: []int{1, 2, 3, 4}, expected: []int{1, 2, 4}}, index: 2, shouldFind: true},
+		{linkedListScenario: linkedListScenario[int]{name: "remove at tail", values: []int{1, 2, 3, 4}, expected: []int{1, 2, 3}}, index: 3, shouldFind: true},
+		{linkedListScenario: linkedListScenario[int]{name: "remove at invalid index", values: []int{1, 2, 3, 4}, expected: []int{1, 2, 3, 4}}, index: 4, shouldFind: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			ll := NewLinkedList[int]()
+			s.setup(ll)
+			removed, found := ll.RemoveAt(s.index)
+			if found != s.shouldFind {
+				t.Fatalf("Expected RemoveAt(%d) to return %v", s.index, s.shouldFind)
+			}
+			if found && *removed != s.values[s.index] {
+				t.Fatalf("Expected removed element to be %v, but found %v", s.values[s.index], *removed)
+			}
+			s.test(t, ll)
+		})
+	}
+}
+
+func TestLinkedList_RemoveRange(t *testing.T) {
+	scenarios := []struct {
+		linkedListScenario[int]
+		from, to      int
+		shouldSucceed bool
+	}{
+		{linkedListScenario: linkedListScenario[int]{name: "remove empty range", values: []int{1, 2, 3}, expected: []int{1, 2, 3}}, from: 3, to: 3, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "remove head range", values: []int{1, 2, 3}, expected: []int{3}}, from: 0, to: 2, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "remove middle range", values: []int{1, 2, 3, 4}, expected: []int{1, 4}}, from: 1, to: 3, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "remove tail range", values: []int{1, 2, 3}, expected: []int{1}}, from: 1, to: 3, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "remove everything", values: []int{1, 2, 3}, expected: nil}, from: 0, to: 3, shouldSucceed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "remove invalid range", values: []int{1, 2, 3}, expected: []int{1, 2, 3}}, from: -1, to: 2, shouldSucceed: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			ll := NewLinkedList[int]()
+			s.setup(ll)
+			if success := ll.RemoveRange(s.from, s.to); success != s.shouldSucceed {
+				t.Fatalf("Expected RemoveRange(%d, %d) to return %v", s.from, s.to, s.shouldSucceed)
+			}
+			s.test(t, ll)
+		})
+	}
+}
+
+func TestLinkedList_RemoveIf(t *testing.T) {
+	scenarios := []struct {
+		linkedListScenario[int]
+		removed bool
+	}{
+		{linkedListScenario: linkedListScenario[int]{name: "remove if on empty list", values: []int{}, expected: nil}, removed: false},
+		{linkedListScenario: linkedListScenario[int]{name: "remove if nothing matches", values: []int{1, 3}, expected: []int{1, 3}}, removed: false},
+		{linkedListScenario: linkedListScenario[int]{name: "remove if ends match", values: []int{2, 3, 4}, expected: []int{3}}, removed: true},
+		{linkedListScenario: linkedListScenario[int]{name: "remove if everything matches", values: []int{2, 4}, expected: nil}, removed: true},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			ll := NewLinkedList[int]()
+			s.setup(ll)
+			if removed := ll.RemoveIf(func(v int) bool { return v%2 == 0 }); removed != s.removed {
+				t.Fatalf("Expected RemoveIf to return %v", s.removed)
+			}
+			s.test(t, ll)
+		})
+	}
+}
+
+func TestLinkedList_Sort(t *testing.T) {
+	scenarios := []linkedListScenario[int]{
+		{name: "sort empty list", values: []int{}, expected: nil},
+		{name: "sort list with one element", values: []int{1}, expected: []int{1}},
+		{name: "sort sorted list", values: []int{1, 2, 3}, expected: []int{1, 2, 3}},
+		{name: "sort reversed list", values: []int{3, 2, 1}, expected: []int{1, 2, 3}},
+		{name: "sort list with duplicates", values: []int{4, 1, 3, 1, 5, 2}, expected: []int{1, 1, 2, 3, 4, 5}},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			ll := NewLinkedList[int]()
+			s.setup(ll)
+			ll.Sort(func(a, b int) int { return a - b })
+			s.test(t, ll)
+		})
+	}
+}
+
+func TestLinkedList_SortStable(t *testing.T) {
+	s := linkedListScenario[string]{
+		values:   []string{"bb", "a", "cc", "b", "aa"},
+		expected: []string{"a", "b", "bb", "cc", "aa"},
+	}
+	ll := NewLinkedList[string]()
+	s.setup(ll)
+	ll.SortStable(func(a, b string) int { return len(a) - len(b) })
+	s.test(t, ll)
+}
+
+func TestLinkedList_Sort_DoesNotAllocate(t *testing.T) {
+	ll := NewLinkedList[int]()
+	for i := 0; i < 1000; i++ {
+		ll.Add((i * 7919) % 1000)
+	}
+	compare := func(a, b int) int { return a - b }
+	reverse := func(a, b int) int { return b - a }
+
+	allocs := testing.AllocsPerRun(10, func() {
+		ll.Sort(compare)
+		ll.Sort(reverse)
+	})
+	if allocs != 0 {
+		t.Fatalf("Expected sort to not allocate, but found %v allocations", allocs)
+	}
+}
+
+func TestLinkedList_JSON(t *testing.T) {
+	scenarios := []linkedListScenario[int]{
+		{name: "encode empty list", values: []int{}, expected: nil},
+		{name: "encode list with multiple element", values: []int{3, 1, 2}, expected: []int{3, 1, 2}},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			ll := NewLinkedList[int]()
+			s.setup(ll)
+			data, err := json.Marshal(ll)
+			if err != nil {
+				t.Fatalf("Expected no error, but found %v", err)
+			}
+
+			decoded := NewLinkedList[int]()
+			decoded.Add(9)
+			if err := json.Unmarshal(data, decoded); err != nil {
+				t.Fatalf("Expected no error, but found %v", err)
+			}
+			s.test(t, decoded)
+		})
+	}
+}
+
+func TestLinkedList_Gob(t *testing.T) {
+	s := linkedListScenario[string]{values: []string{"a", "b"}, expected: []string{"a", "b"}}
+	ll := NewLinkedList[string]()
+	s.setup(ll)
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(ll); err != nil {
+		t.Fatalf("Expected no error, but found %v", err)
+	}
+	decoded := NewLinkedList[string]()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Fatalf("Expected no error, but found %v", err)
+	}
+	s.test(t, decoded)
+
+	data, err := ll.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		t.Fatalf("Expected no error, but found %v", err)
+	}
+	decoded = NewLinkedList[string]()
+	if err := decoded.(encoding.BinaryUnmarshaler).UnmarshalBinary(data); err != nil {
+		t.Fatalf("Expected no error, but found %v", err)
+	}
+	s.test(t, decoded)
+}
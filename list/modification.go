@@ -0,0 +1,24 @@
+package list
+
+// modifiable is implemented by lists that count their structural
+// modifications, so that iterators and views of them can detect changes they
+// did not make.
+type modifiable interface {
+	modifications() int
+}
+
+func (a *arrayList[T]) modifications() int {
+	return a.modCount
+}
+
+func (l *linkedList[T]) modifications() int {
+	return l.modCount
+}
+
+// checkModifications panics with ErrConcurrentModification if l was
+// structurally modified since its modification count was expected.
+func checkModifications(l modifiable, expected int) {
+	if l.modifications() != expected {
+		panic(ErrConcurrentModification)
+	}
+}
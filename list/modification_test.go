@@ -0,0 +1,133 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestList_ConcurrentModification(t *testing.T) {
+	for _, c := range subListConstructors {
+		t.Run(c.name, func(t *testing.T) {
+			newList := func() List[int] {
+				l := c.new()
+				l.AddAll(0, 1, 2, 3, 4)
+				return l
+			}
+
+			t.Run("Iterator", func(t *testing.T) {
+				l := newList()
+				it := l.Iterator()
+				it.Next()
+				l.Add(5)
+				expectConcurrentModification(t, func() { it.Next() })
+
+				it = l.Iterator()
+				it.Next()
+				l.RemoveAt(0)
+				expectConcurrentModification(t, func() { it.Remove() })
+			})
+
+			t.Run("Iterator Remove", func(t *testing.T) {
+				l := newList()
+				it := l.Iterator()
+				for it.HasNext() {
+					if e, _ := it.Next(); *e%2 == 0 {
+						it.Remove()
+					}
+				}
+				if got := l.Values(); !slices.Equal(got, []int{1, 3}) {
+					t.Fatalf("Expected [1, 3] after removing through the iterator, but got %v", got)
+				}
+			})
+
+			t.Run("Set is not structural", func(t *testing.T) {
+				l := newList()
+				it := l.Iterator()
+				it.Next()
+				l.Set(0, 10)
+				if e, ok := it.Next(); !ok || *e != 1 {
+					t.Fatalf("Expected Next to return 1 after Set, but got %v", e)
+				}
+			})
+
+			t.Run("All", func(t *testing.T) {
+				l := newList()
+				expectConcurrentModification(t, func() {
+					for e := range l.All() {
+						if e == 1 {
+							l.Remove(e)
+						}
+					}
+				})
+			})
+
+			t.Run("All break after modification", func(t *testing.T) {
+				l := newList()
+				for e := range l.All() {
+					l.Add(e)
+					break
+				}
+				if got := l.Size(); got != 6 {
+					t.Fatalf("Expected size 6, but got %d", got)
+				}
+			})
+
+			t.Run("Backward", func(t *testing.T) {
+				l := newList()
+				expectConcurrentModification(t, func() {
+					for _, e := range l.Backward() {
+						l.Add(e)
+					}
+				})
+			})
+
+			t.Run("RemoveIf", func(t *testing.T) {
+				l := newList()
+				expectConcurrentModification(t, func() {
+					l.RemoveIf(func(e int) bool {
+						if e == 2 {
+							l.Add(10)
+						}
+						return e%2 == 0
+					})
+				})
+
+				l = newList()
+				if !l.RemoveIf(func(e int) bool { return e%2 == 1 }) {
+					t.Fatalf("Expected RemoveIf to remove the odd elements")
+				}
+				if got := l.Values(); !slices.Equal(got, []int{0, 2, 4}) {
+					t.Fatalf("Expected [0, 2, 4], but got %v", got)
+				}
+			})
+
+			t.Run("Sort", func(t *testing.T) {
+				l := newList()
+				expectConcurrentModification(t, func() {
+					added := false
+					l.Sort(func(a, b int) int {
+						if !added {
+							added = true
+							l.Add(10)
+						}
+						return b - a
+					})
+				})
+			})
+
+			t.Run("SubList Iterator", func(t *testing.T) {
+				l := newList()
+				sub, _ := l.SubList(1, 4)
+				it := sub.Iterator()
+				it.Next()
+				sub.Add(7)
+				expectConcurrentModification(t, func() { it.Next() })
+
+				it = sub.Iterator()
+				it.Next()
+				l.Add(8)
+				expectConcurrentModification(t, func() { it.Next() })
+			})
+		})
+	}
+}
@@ -0,0 +1,459 @@
+package list
+
+import (
+	"fmt"
+	"hash/maphash"
+	"iter"
+	"slices"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// subList is a view of the elements of parent between offset, inclusive, and
+// offset+size, exclusive. The parent is either a backing list or another view.
+// Every operation of the view is translated into an operation of the parent,
+// after checking that the parent has not been structurally modified since the
+// view last saw it.
+type subList[T any] struct {
+	parent   List[T]
+	offset   int
+	size     int
+	modCount int
+	equal    func(a, b T) bool
+	hash     func(seed maphash.Seed, v T) uint64
+}
+
+func newSubList[T any](parent List[T], equal func(a, b T) bool, hash func(seed maphash.Seed, v T) uint64, from, to int) (List[T], bool) {
+	if from < 0 || to > parent.Size() || from > to {
+		return nil, false
+	}
+	return &subList[T]{
+		parent:   parent,
+		offset:   from,
+		size:     to - from,
+		modCount: parent.(modifiable).modifications(),
+		equal:    equal,
+		hash:     hash,
+	}, true
+}
+
+func (a *arrayList[T]) SubList(from, to int) (List[T], bool) {
+	return newSubList[T](a, a.equal, a.hash, from, to)
+}
+
+func (l *linkedList[T]) SubList(from, to int) (List[T], bool) {
+	return newSubList[T](l, l.equal, l.hash, from, to)
+}
+
+// ranged is implemented by the parents of views, so that a view can visit,
+// replace and remove its elements in a single pass over its parent instead of
+// one lookup by index per element. The ranges are valid indices of the list.
+type ranged[T any] interface {
+	// between returns an iterator over the elements between from, inclusive,
+	// and to, exclusive.
+	between(from, to int) iter.Seq[T]
+
+	// setBetween replaces the elements starting at from with values, without
+	// structurally modifying the list.
+	setBetween(from int, values []T)
+
+	// removeBetween removes the elements between from, inclusive, and to,
+	// exclusive, that satisfy the predicate, and returns how many it removed.
+	removeBetween(from, to int, predicate func(element T) bool) int
+
+	// walk returns a function returning the element at from on its first
+	// call and the following elements on the next calls. It is valid until
+	// the list is structurally modified, and must not be called past its end.
+	walk(from int) func() T
+}
+
+func (a *arrayList[T]) between(from, to int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		modCount := a.modCount
+		for _, e := range a.elements[from:to] {
+			if !yield(e) {
+				return
+			}
+			checkModifications(a, modCount)
+		}
+	}
+}
+
+func (a *arrayList[T]) walk(from int) func() T {
+	return func() T {
+		from++
+		return a.elements[from-1]
+	}
+}
+
+func (a *arrayList[T]) setBetween(from int, values []T) {
+	copy(a.elements[from:], values)
+}
+
+func (a *arrayList[T]) removeBetween(from, to int, predicate func(element T) bool) int {
+	modCount := a.modCount
+	kept := slices.DeleteFunc(a.elements[from:to], func(e T) bool {
+		remove := predicate(e)
+		checkModifications(a, modCount)
+		return remove
+	})
+	removed := to - from - len(kept)
+	if removed > 0 {
+		a.elements = slices.Delete(a.elements, from+len(kept), to)
+		a.modCount++
+	}
+	return removed
+}
+
+func (l *linkedList[T]) between(from, to int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		modCount := l.modCount
+		current := l.nodeAt(from)
+		for i := from; i < to; i++ {
+			if !yield(current.Value) {
+				return
+			}
+			checkModifications(l, modCount)
+			current = current.next
+		}
+	}
+}
+
+func (l *linkedList[T]) walk(from int) func() T {
+	current := l.nodeAt(from)
+	return func() T {
+		value := current.Value
+		current = current.next
+		return value
+	}
+}
+
+func (l *linkedList[T]) setBetween(from int, values []T) {
+	current := l.nodeAt(from)
+	for _, v := range values {
+		current.Value = v
+		current = current.next
+	}
+}
+
+func (l *linkedList[T]) removeBetween(from, to int, predicate func(element T) bool) int {
+	removed := 0
+	modCount := l.modCount
+	current := l.nodeAt(from)
+	for i := from; i < to; i++ {
+		next := current.next
+		remove := predicate(current.Value)
+		checkModifications(l, modCount)
+		if remove {
+			l.unlink(current)
+			modCount = l.modCount
+			removed++
+		}
+		current = next
+	}
+	return removed
+}
+
+func (s *subList[T]) between(from, to int) iter.Seq[T] {
+	return s.parent.(ranged[T]).between(s.offset+from, s.offset+to)
+}
+
+func (s *subList[T]) walk(from int) func() T {
+	return s.parent.(ranged[T]).walk(s.offset + from)
+}
+
+func (s *subList[T]) setBetween(from int, values []T) {
+	s.parent.(ranged[T]).setBetween(s.offset+from, values)
+}
+
+func (s *subList[T]) removeBetween(from, to int, predicate func(element T) bool) int {
+	removed := s.parent.(ranged[T]).removeBetween(s.offset+from, s.offset+to, predicate)
+	if removed > 0 {
+		s.modified(-removed)
+	}
+	return removed
+}
+
+func (s *subList[T]) modifications() int {
+	return s.parent.(modifiable).modifications()
+}
+
+// check panics with ErrConcurrentModification if the parent was structurally
+// modified other than through the view.
+func (s *subList[T]) check() {
+	checkModifications(s, s.modCount)
+}
+
+// modified records a structural modification made through the view that
+// changed its size by delta.
+func (s *subList[T]) modified(delta int) {
+	s.size += delta
+	s.modCount = s.modifications()
+}
+
+func (s *subList[T]) Size() int {
+	s.check()
+	return s.size
+}
+
+func (s *subList[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+func (s *subList[T]) Contains(element T) bool {
+	_, found := s.IndexOf(element)
+	return found
+}
+
+func (s *subList[T]) String() string {
+	str := "SubList(["
+	for i, e := range s.Values() {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", e)
+	}
+	return str + "])"
+}
+
+func (s *subList[T]) Values() []T {
+	var values []T
+	for e := range s.All() {
+		values = append(values, e)
+	}
+	return values
+}
+
+func (s *subList[T]) Add(element T) {
+	s.AddAt(s.Size(), element)
+}
+
+func (s *subList[T]) AddAt(index int, element T) bool {
+	return s.AddAllAt(index, element)
+}
+
+func (s *subList[T]) AddAll(elements ...T) {
+	s.AddAllAt(s.Size(), elements...)
+}
+
+func (s *subList[T]) AddAllAt(index int, elements ...T) bool {
+	s.check()
+	if index < 0 || index > s.size {
+		return false
+	}
+	s.parent.AddAllAt(s.offset+index, elements...)
+	s.modified(len(elements))
+	return true
+}
+
+func (s *subList[T]) Clear() {
+	s.RemoveRange(0, s.Size())
+}
+
+func (s *subList[T]) Remove(element T) bool {
+	index, found := s.IndexOf(element)
+	if !found {
+		return false
+	}
+	s.RemoveAt(index)
+	return true
+}
+
+func (s *subList[T]) RemoveAt(index int) (*T, bool) {
+	s.check()
+	if index < 0 || index >= s.size {
+		return nil, false
+	}
+	removed, _ := s.parent.RemoveAt(s.offset + index)
+	s.modified(-1)
+	return removed, true
+}
+
+func (s *subList[T]) RemoveRange(from, to int) bool {
+	s.check()
+	if from < 0 || to > s.size || from > to {
+		return false
+	}
+	s.parent.RemoveRange(s.offset+from, s.offset+to)
+	s.modified(from - to)
+	return true
+}
+
+func (s *subList[T]) RemoveIf(predicate func(element T) bool) bool {
+	s.check()
+	return s.removeBetween(0, s.size, predicate) > 0
+}
+
+func (s *subList[T]) Set(index int, element T) (*T, bool) {
+	s.check()
+	if index < 0 || index >= s.size {
+		return nil, false
+	}
+	return s.parent.Set(s.offset+index, element)
+}
+
+func (s *subList[T]) Get(index int) (*T, bool) {
+	s.check()
+	if index < 0 || index >= s.size {
+		return nil, false
+	}
+	return s.parent.Get(s.offset + index)
+}
+
+func (s *subList[T]) IndexOf(element T) (int, bool) {
+	i := 0
+	for e := range s.All() {
+		if s.equal(e, element) {
+			return i, true
+		}
+		i++
+	}
+	return -1, false
+}
+
+func (s *subList[T]) LastIndexOf(element T) (int, bool) {
+	for i, e := range s.Backward() {
+		if s.equal(e, element) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func (s *subList[T]) Iterator() base.Iterator[T] {
+	s.check()
+	return &subListIterator[T]{list: s, last: -1, modCount: s.modCount}
+}
+
+func (s *subList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.check()
+		for e := range s.between(0, s.size) {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+func (s *subList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		values := s.Values()
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(i, values[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (s *subList[T]) Sort(compare func(a, b T) int) {
+	values := s.Values()
+	slices.SortFunc(values, compare)
+	s.check()
+	s.setBetween(0, values)
+}
+
+func (s *subList[T]) SortStable(compare func(a, b T) int) {
+	values := s.Values()
+	slices.SortStableFunc(values, compare)
+	s.check()
+	s.setBetween(0, values)
+}
+
+func (s *subList[T]) SubList(from, to int) (List[T], bool) {
+	s.check()
+	return newSubList[T](s, s.equal, s.hash, from, to)
+}
+
+// subListIterator walks the elements of the parent of the view, and keeps the
+// index of the last element returned so that it can be removed. The walk is
+// restarted at the cursor after a removal.
+type subListIterator[T any] struct {
+	list     *subList[T]
+	next     func() T
+	cursor   int
+	last     int
+	modCount int
+}
+
+// check panics with ErrConcurrentModification if the view was structurally
+// modified other than through the iterator.
+func (it *subListIterator[T]) check() {
+	if it.list.modCount != it.modCount {
+		panic(ErrConcurrentModification)
+	}
+}
+
+func (it *subListIterator[T]) HasNext() bool {
+	return it.cursor < it.list.Size()
+}
+
+func (it *subListIterator[T]) Next() (*T, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	it.check()
+	if it.next == nil {
+		it.next = it.list.walk(it.cursor)
+	}
+	it.last = it.cursor
+	it.cursor++
+	value := it.next()
+	return &value, true
+}
+
+func (it *subListIterator[T]) Remove() bool {
+	if it.last < 0 {
+		return false
+	}
+	it.check()
+	it.list.RemoveAt(it.last)
+	it.modCount = it.list.modCount
+	it.next = nil
+	it.cursor = it.last
+	it.last = -1
+	return true
+}
+
+func (s *subList[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(s.Values())
+}
+
+func (s *subList[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *subList[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(s.Values())
+}
+
+func (s *subList[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *subList[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+func (s *subList[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the view, and the corresponding elements of
+// the backing list, with the decoded values.
+func (s *subList[T]) replace(values []T) {
+	s.Clear()
+	s.AddAll(values...)
+}
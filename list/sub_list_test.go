@@ -0,0 +1,279 @@
+package list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+var subListConstructors = []struct {
+	name string
+	new  func() List[int]
+}{
+	{name: "ArrayList", new: func() List[int] { return NewArrayList[int]() }},
+	{name: "LinkedList", new: func() List[int] { return NewLinkedList[int]() }},
+}
+
+// expectConcurrentModification fails the test unless fn panics with ErrConcurrentModification.
+func expectConcurrentModification(t *testing.T, fn func()) {
+	t.Helper()
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, ErrConcurrentModification) {
+			t.Fatalf("Expected a panic with ErrConcurrentModification, but got %v", err)
+		}
+	}()
+	fn()
+}
+
+func TestList_SubList(t *testing.T) {
+	for _, c := range subListConstructors {
+		t.Run(c.name, func(t *testing.T) {
+			l := c.new()
+			l.AddAll(0, 1, 2, 3, 4, 5)
+
+			if _, ok := l.SubList(4, 2); ok {
+				t.Fatalf("Expected SubList(4, 2) to fail")
+			}
+			if _, ok := l.SubList(0, 7); ok {
+				t.Fatalf("Expected SubList(0, 7) to fail")
+			}
+
+			view, _ := l.SubList(1, 4)
+			if values := view.Values(); !slices.Equal(values, []int{1, 2, 3}) {
+				t.Fatalf("Expected view [1 2 3], but got %v", values)
+			}
+			if view.String() != "SubList([1, 2, 3])" {
+				t.Fatalf("Expected SubList([1, 2, 3]), but got %v", view.String())
+			}
+			if v, _ := view.Get(0); *v != 1 {
+				t.Fatalf("Expected Get(0) to return 1, but got %v", *v)
+			}
+			if _, ok := view.Get(3); ok {
+				t.Fatalf("Expected Get(3) to be out of range")
+			}
+			if i, _ := view.IndexOf(3); i != 2 {
+				t.Fatalf("Expected IndexOf(3) to return 2, but got %v", i)
+			}
+			if view.Contains(4) {
+				t.Fatalf("Expected view to not contain 4")
+			}
+
+			l.Set(2, 20)
+			if v, _ := view.Get(1); *v != 20 {
+				t.Fatalf("Expected Set on the list to be visible through the view, but got %v", *v)
+			}
+		})
+	}
+}
+
+func TestList_SubList_Mutation(t *testing.T) {
+	for _, c := range subListConstructors {
+		t.Run(c.name, func(t *testing.T) {
+			l := c.new()
+			l.AddAll(0, 1, 2, 3, 4, 5)
+			view, _ := l.SubList(1, 4)
+
+			view.Set(0, 10)
+			view.Add(30)
+			view.AddAt(0, 9)
+			view.Remove(2)
+			if values := l.Values(); !slices.Equal(values, []int{0, 9, 10, 3, 30, 4, 5}) {
+				t.Fatalf("Expected [0 9 10 3 30 4 5], but got %v", values)
+			}
+			if view.Size() != 4 {
+				t.Fatalf("Expected view to have 4 elements, but got %v", view.Size())
+			}
+
+			view.RemoveIf(func(v int) bool { return v > 9 })
+			if values := view.Values(); !slices.Equal(values, []int{9, 3}) {
+				t.Fatalf("Expected view [9 3], but got %v", values)
+			}
+			view.Sort(func(a, b int) int { return a - b })
+			if values := l.Values(); !slices.Equal(values, []int{0, 3, 9, 4, 5}) {
+				t.Fatalf("Expected [0 3 9 4 5], but got %v", values)
+			}
+
+			view.Clear()
+			if values := l.Values(); !slices.Equal(values, []int{0, 4, 5}) {
+				t.Fatalf("Expected clearing the view to remove its elements from the list, but got %v", values)
+			}
+			if !view.IsEmpty() {
+				t.Fatalf("Expected view to be empty")
+			}
+		})
+	}
+}
+
+func TestList_SubList_Nested(t *testing.T) {
+	for _, c := range subListConstructors {
+		t.Run(c.name, func(t *testing.T) {
+			l := c.new()
+			l.AddAll(0, 1, 2, 3, 4, 5, 6)
+			outer, _ := l.SubList(1, 6)
+			inner, _ := outer.SubList(1, 3)
+
+			inner.RemoveAt(0)
+			inner.Add(7)
+			if values := outer.Values(); !slices.Equal(values, []int{1, 3, 7, 4, 5}) {
+				t.Fatalf("Expected outer view [1 3 7 4 5], but got %v", values)
+			}
+			if values := l.Values(); !slices.Equal(values, []int{0, 1, 3, 7, 4, 5, 6}) {
+				t.Fatalf("Expected list [0 1 3 7 4 5 6], but got %v", values)
+			}
+
+			outer.Add(8)
+			expectConcurrentModification(t, func() { inner.Size() })
+		})
+	}
+}
+
+func TestList_SubList_RemoveIf(t *testing.T) {
+	for _, c := range subListConstructors {
+		t.Run(c.name, func(t *testing.T) {
+			l := c.new()
+			l.AddAll(0, 1, 2, 3, 4, 5, 6)
+			outer, _ := l.SubList(1, 6)
+			inner, _ := outer.SubList(1, 4)
+			sibling, _ := l.SubList(0, 2)
+
+			if inner.RemoveIf(func(v int) bool { return v > 9 }) {
+				t.Fatalf("Expected RemoveIf to return false when no element matches")
+			}
+			if values := sibling.Values(); !slices.Equal(values, []int{0, 1}) {
+				t.Fatalf("Expected a RemoveIf removing nothing to leave other views valid, but got %v", values)
+			}
+
+			if !inner.RemoveIf(func(v int) bool { return v%2 == 0 }) {
+				t.Fatalf("Expected RemoveIf to return true")
+			}
+			if values := inner.Values(); !slices.Equal(values, []int{3}) {
+				t.Fatalf("Expected inner view [3], but got %v", values)
+			}
+			if values := outer.Values(); !slices.Equal(values, []int{1, 3, 5}) {
+				t.Fatalf("Expected outer view [1 3 5], but got %v", values)
+			}
+			if values := l.Values(); !slices.Equal(values, []int{0, 1, 3, 5, 6}) {
+				t.Fatalf("Expected list [0 1 3 5 6], but got %v", values)
+			}
+		})
+	}
+}
+
+func TestLinkedList_SubList_RemoveIfKeepsElements(t *testing.T) {
+	l := NewLinkedList[int]()
+	elements := []*Element[int]{l.PushBack(1), l.PushBack(2), l.PushBack(3), l.PushBack(4)}
+	view, _ := l.SubList(0, 4)
+
+	view.RemoveIf(func(v int) bool { return v == 2 })
+	view.Sort(func(a, b int) int { return b - a })
+	if values := l.Values(); !slices.Equal(values, []int{4, 3, 1}) {
+		t.Fatalf("Expected [4 3 1], but got %v", values)
+	}
+	for _, i := range []int{0, 2, 3} {
+		if elements[i].Next() == nil && elements[i].Prev() == nil {
+			t.Fatalf("Expected the element handle of %v to stay in the list", i+1)
+		}
+	}
+	if elements[1].Next() != nil || elements[1].Prev() != nil {
+		t.Fatalf("Expected the element handle of 2 to be removed")
+	}
+}
+
+func TestList_SubList_Iterator(t *testing.T) {
+	for _, c := range subListConstructors {
+		t.Run(c.name, func(t *testing.T) {
+			l := c.new()
+			l.AddAll(0, 1, 2, 3, 4, 5)
+			view, _ := l.SubList(1, 5)
+
+			it := view.Iterator()
+			for it.HasNext() {
+				if v, _ := it.Next(); *v%2 == 0 {
+					it.Remove()
+				}
+			}
+			if values := l.Values(); !slices.Equal(values, []int{0, 1, 3, 5}) {
+				t.Fatalf("Expected [0 1 3 5], but got %v", values)
+			}
+		})
+	}
+}
+
+func TestList_SubList_IteratorWalk(t *testing.T) {
+	for _, c := range subListConstructors {
+		t.Run(c.name, func(t *testing.T) {
+			l := c.new()
+			l.AddAll(0, 1, 2, 3, 4, 5, 6)
+			outer, _ := l.SubList(1, 6)
+			view, _ := outer.SubList(1, 5)
+
+			var visited []int
+			it := view.Iterator()
+			for it.HasNext() {
+				v, _ := it.Next()
+				visited = append(visited, *v)
+				switch *v {
+				case 2:
+					l.Set(4, 40)
+				case 3:
+					it.Remove()
+				}
+			}
+			if !slices.Equal(visited, []int{2, 3, 40, 5}) {
+				t.Fatalf("Expected to visit [2 3 40 5], but got %v", visited)
+			}
+			if values := l.Values(); !slices.Equal(values, []int{0, 1, 2, 40, 5, 6}) {
+				t.Fatalf("Expected [0 1 2 40 5 6], but got %v", values)
+			}
+
+			other := NewArrayListFrom([]int{2, 40, 5})
+			if !view.Equals(other) || Compare(view, other) != 0 {
+				t.Fatalf("Expected the view %v to equal %v", view.Values(), other.Values())
+			}
+
+			it = view.Iterator()
+			it.Next()
+			l.Add(7)
+			expectConcurrentModification(t, func() { it.Next() })
+		})
+	}
+}
+
+func TestList_SubList_ConcurrentModification(t *testing.T) {
+	for _, c := range subListConstructors {
+		scenarios := []struct {
+			name   string
+			modify func(l List[int])
+		}{
+			{name: "Add", modify: func(l List[int]) { l.Add(9) }},
+			{name: "RemoveAt", modify: func(l List[int]) { l.RemoveAt(0) }},
+			{name: "Clear", modify: func(l List[int]) { l.Clear() }},
+			{name: "Sort", modify: func(l List[int]) { l.Sort(func(a, b int) int { return b - a }) }},
+		}
+		for _, s := range scenarios {
+			t.Run(c.name+" "+s.name, func(t *testing.T) {
+				l := c.new()
+				l.AddAll(0, 1, 2, 3)
+				view, _ := l.SubList(1, 3)
+				s.modify(l)
+
+				expectConcurrentModification(t, func() { view.Get(0) })
+				expectConcurrentModification(t, func() { view.Add(1) })
+				expectConcurrentModification(t, func() { view.Values() })
+			})
+		}
+	}
+}
+
+func TestUnmodifiable_SubList(t *testing.T) {
+	l := NewArrayList[int]()
+	l.AddAll(1, 2, 3)
+	view, _ := Unmodifiable[int](l).SubList(0, 2)
+
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, ErrUnmodifiable) {
+			t.Fatalf("Expected a panic with ErrUnmodifiable, but got %v", err)
+		}
+	}()
+	view.Add(4)
+}
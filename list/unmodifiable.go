@@ -0,0 +1,187 @@
+package list
+
+import (
+	"errors"
+	"hash/maphash"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// ErrUnmodifiable is the value unmodifiable lists panic with when a method
+// that would modify them is called.
+var ErrUnmodifiable = errors.New("list: unmodifiable list")
+
+type unmodifiableList[T any] struct {
+	list List[T]
+}
+
+// Unmodifiable returns a read-only view of l. Changes made to l are visible
+// through the view, but every method of the view that would modify the list,
+// including Remove on its iterator, panics with ErrUnmodifiable. Elements
+// returned by Get are copies, so they cannot be used to modify l either.
+func Unmodifiable[T any](l List[T]) List[T] {
+	if u, ok := l.(*unmodifiableList[T]); ok {
+		return u
+	}
+	return &unmodifiableList[T]{list: l}
+}
+
+func (u *unmodifiableList[T]) Contains(element T) bool {
+	return u.list.Contains(element)
+}
+
+func (u *unmodifiableList[T]) Clear() {
+	panic(ErrUnmodifiable)
+}
+
+func (u *unmodifiableList[T]) IsEmpty() bool {
+	return u.list.IsEmpty()
+}
+
+func (u *unmodifiableList[T]) Size() int {
+	return u.list.Size()
+}
+
+func (u *unmodifiableList[T]) Values() []T {
+	return u.list.Values()
+}
+
+func (u *unmodifiableList[T]) String() string {
+	return u.list.String()
+}
+
+func (u *unmodifiableList[T]) Iterator() base.Iterator[T] {
+	return &unmodifiableIterator[T]{iterator: u.list.Iterator()}
+}
+
+func (u *unmodifiableList[T]) All() iter.Seq[T] {
+	return u.list.All()
+}
+
+func (u *unmodifiableList[T]) Add(T) {
+	panic(ErrUnmodifiable)
+}
+
+func (u *unmodifiableList[T]) AddAt(int, T) bool {
+	panic(ErrUnmodifiable)
+}
+
+func (u *unmodifiableList[T]) AddAll(...T) {
+	panic(ErrUnmodifiable)
+}
+
+func (u *unmodifiableList[T]) AddAllAt(int, ...T) bool {
+	panic(ErrUnmodifiable)
+}
+
+func (u *unmodifiableList[T]) Remove(T) bool {
+	panic(ErrUnmodifiable)
+}
+
+func (u *unmodifiableList[T]) RemoveAt(int) (*T, bool) {
+	panic(ErrUnmodifiable)
+}
+
+func (u *unmodifiableList[T]) RemoveRange(int, int) bool {
+	panic(ErrUnmodifiable)
+}
+
+func (u *unmodifiableList[T]) RemoveIf(func(element T) bool) bool {
+	panic(ErrUnmodifiable)
+}
+
+func (u *unmodifiableList[T]) Set(int, T) (*T, bool) {
+	panic(ErrUnmodifiable)
+}
+
+func (u *unmodifiableList[T]) Get(index int) (*T, bool) {
+	element, ok := u.list.Get(index)
+	if !ok {
+		return nil, false
+	}
+	value := *element
+	return &value, true
+}
+
+func (u *unmodifiableList[T]) IndexOf(element T) (int, bool) {
+	return u.list.IndexOf(element)
+}
+
+func (u *unmodifiableList[T]) LastIndexOf(element T) (int, bool) {
+	return u.list.LastIndexOf(element)
+}
+
+func (u *unmodifiableList[T]) Backward() iter.Seq2[int, T] {
+	return u.list.Backward()
+}
+
+func (u *unmodifiableList[T]) Sort(func(a, b T) int) {
+	panic(ErrUnmodifiable)
+}
+
+func (u *unmodifiableList[T]) SortStable(func(a, b T) int) {
+	panic(ErrUnmodifiable)
+}
+
+func (u *unmodifiableList[T]) SubList(from, to int) (List[T], bool) {
+	sub, ok := u.list.SubList(from, to)
+	if !ok {
+		return nil, false
+	}
+	return Unmodifiable(sub), true
+}
+
+func (u *unmodifiableList[T]) Equals(other base.Collection[T]) bool {
+	return u.list.Equals(other)
+}
+
+func (u *unmodifiableList[T]) Hash(seed maphash.Seed) uint64 {
+	return u.list.Hash(seed)
+}
+
+func (u *unmodifiableList[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(u.list.Values())
+}
+
+func (u *unmodifiableList[T]) UnmarshalJSON([]byte) error {
+	return ErrUnmodifiable
+}
+
+func (u *unmodifiableList[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(u.list.Values())
+}
+
+func (u *unmodifiableList[T]) UnmarshalBinary([]byte) error {
+	return ErrUnmodifiable
+}
+
+func (u *unmodifiableList[T]) GobEncode() ([]byte, error) {
+	return u.MarshalBinary()
+}
+
+func (u *unmodifiableList[T]) GobDecode([]byte) error {
+	return ErrUnmodifiable
+}
+
+type unmodifiableIterator[T any] struct {
+	iterator base.Iterator[T]
+}
+
+func (it *unmodifiableIterator[T]) HasNext() bool {
+	return it.iterator.HasNext()
+}
+
+func (it *unmodifiableIterator[T]) Next() (*T, bool) {
+	element, ok := it.iterator.Next()
+	if !ok {
+		return nil, false
+	}
+	value := *element
+	return &value, true
+}
+
+func (it *unmodifiableIterator[T]) Remove() bool {
+	panic(ErrUnmodifiable)
+}
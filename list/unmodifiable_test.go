@@ -0,0 +1,94 @@
+package list
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestUnmodifiable(t *testing.T) {
+	l := NewArrayList[int]()
+	l.AddAll(1, 2, 3)
+	view := Unmodifiable[int](l)
+
+	if Unmodifiable(view) != view {
+		t.Fatalf("Expected wrapping an unmodifiable list to return it unchanged")
+	}
+	if v, _ := view.Get(1); *v != 2 {
+		t.Fatalf("Expected Get(1) to return 2, but got %v", *v)
+	}
+	if i, _ := view.IndexOf(3); i != 2 {
+		t.Fatalf("Expected IndexOf(3) to return 2, but got %v", i)
+	}
+
+	l.Add(4)
+	if view.Size() != 4 || !view.Contains(4) {
+		t.Fatalf("Expected view to reflect changes to the underlying list, but got %v", view)
+	}
+
+	v, _ := view.Get(0)
+	*v = 10
+	if v, _ := l.Get(0); *v != 1 {
+		t.Fatalf("Expected Get to return a copy, but the underlying list changed to %v", l)
+	}
+	it := view.Iterator()
+	v, _ = it.Next()
+	*v = 10
+	if v, _ := l.Get(0); *v != 1 {
+		t.Fatalf("Expected Next to return a copy, but the underlying list changed to %v", l)
+	}
+}
+
+func TestUnmodifiable_Mutation(t *testing.T) {
+	view := Unmodifiable(NewLinkedList[int]())
+	scenarios := []struct {
+		name   string
+		mutate func()
+	}{
+		{name: "Add", mutate: func() { view.Add(1) }},
+		{name: "AddAt", mutate: func() { view.AddAt(0, 1) }},
+		{name: "AddAll", mutate: func() { view.AddAll(1, 2) }},
+		{name: "AddAllAt", mutate: func() { view.AddAllAt(0, 1, 2) }},
+		{name: "Remove", mutate: func() { view.Remove(1) }},
+		{name: "RemoveAt", mutate: func() { view.RemoveAt(0) }},
+		{name: "RemoveRange", mutate: func() { view.RemoveRange(0, 0) }},
+		{name: "RemoveIf", mutate: func() { view.RemoveIf(func(int) bool { return true }) }},
+		{name: "Set", mutate: func() { view.Set(0, 1) }},
+		{name: "Clear", mutate: func() { view.Clear() }},
+		{name: "Sort", mutate: func() { view.Sort(func(a, b int) int { return a - b }) }},
+		{name: "SortStable", mutate: func() { view.SortStable(func(a, b int) int { return a - b }) }},
+		{name: "Iterator.Remove", mutate: func() { view.Iterator().Remove() }},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			defer func() {
+				if err, _ := recover().(error); !errors.Is(err, ErrUnmodifiable) {
+					t.Fatalf("Expected %v to panic with ErrUnmodifiable, but got %v", s.name, err)
+				}
+			}()
+			s.mutate()
+		})
+	}
+}
+
+func TestUnmodifiable_JSON(t *testing.T) {
+	l := NewArrayList[int]()
+	l.AddAll(1, 2)
+	view := Unmodifiable[int](l)
+
+	data, err := json.Marshal(view)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if string(data) != "[1,2]" {
+		t.Fatalf("Expected [1,2], but got %s", data)
+	}
+	if err := json.Unmarshal(data, view); !errors.Is(err, ErrUnmodifiable) {
+		t.Fatalf("Expected decoding into the view to fail with ErrUnmodifiable, but got %v", err)
+	}
+	if values := l.Values(); !slices.Equal(values, []int{1, 2}) {
+		t.Fatalf("Expected the underlying list to be unchanged, but got %v", values)
+	}
+}
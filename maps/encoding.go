@@ -0,0 +1,100 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// stringKeys returns true if the keys are strings, which makes maps encode as
+// JSON objects rather than arrays of entries.
+func stringKeys[K any]() bool {
+	return reflect.TypeFor[K]().Kind() == reflect.String
+}
+
+// marshalJSON encodes the entries of the map, in its iteration order, as a
+// JSON object if its keys are strings, and as an array of entries otherwise.
+func marshalJSON[K, V any](m Map[K, V]) ([]byte, error) {
+	if !stringKeys[K]() {
+		entries := m.Entries()
+		if entries == nil {
+			entries = []Entry[K, V]{}
+		}
+		return json.Marshal(entries)
+	}
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	i := 0
+	for k, v := range m.All() {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		key, _ := json.Marshal(reflect.ValueOf(k).String())
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(key)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+		i++
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// unmarshalJSON decodes the entries encoded by marshalJSON, in order.
+func unmarshalJSON[K, V any](data []byte) ([]Entry[K, V], error) {
+	var entries []Entry[K, V]
+	if !stringKeys[K]() {
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return nil, err
+		}
+		return entries, nil
+	}
+	// Decoding token by token keeps the keys in the order of the object,
+	// which unmarshalling into a Go map would lose.
+	if !json.Valid(data) {
+		return nil, json.Unmarshal(data, new(any))
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	start, _ := decoder.Token()
+	if start == nil {
+		return nil, nil
+	}
+	if start != json.Delim('{') {
+		return nil, errors.New("maps: expected a JSON object")
+	}
+	for decoder.More() {
+		key, _ := decoder.Token()
+		var e Entry[K, V]
+		reflect.ValueOf(&e.Key).Elem().SetString(key.(string))
+		if err := decoder.Decode(&e.Value); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// marshalBinary encodes the entries of the map, in its iteration order, as a
+// gob-encoded slice.
+func marshalBinary[K, V any](m Map[K, V]) ([]byte, error) {
+	return encoding.MarshalBinary(m.Entries())
+}
+
+// unmarshalBinary decodes the entries encoded by marshalBinary, in order.
+func unmarshalBinary[K, V any](data []byte) ([]Entry[K, V], error) {
+	return encoding.UnmarshalBinary[Entry[K, V]](data)
+}
+
+// replace replaces the entries of the map with the decoded ones.
+func replace[K, V any](m Map[K, V], entries []Entry[K, V]) {
+	m.Clear()
+	for _, e := range entries {
+		m.Put(e.Key, e.Value)
+	}
+}
@@ -0,0 +1,149 @@
+package maps
+
+import (
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/set"
+)
+
+type hashMap[K comparable, V any] struct {
+	entries map[K]V
+	equal   func(a, b V) bool
+}
+
+// NewHashMap returns an empty Map backed by a Go map. The iteration order of
+// the map is unspecified. Values are compared using reflect.DeepEqual.
+func NewHashMap[K comparable, V any]() Map[K, V] {
+	return NewHashMapFunc[K](base.DeepEqual[V])
+}
+
+// NewHashMapFunc returns an empty Map backed by a Go map that compares values
+// using the given equal function. If equal is nil, reflect.DeepEqual is used.
+func NewHashMapFunc[K comparable, V any](equal func(a, b V) bool) Map[K, V] {
+	if equal == nil {
+		equal = base.DeepEqual[V]
+	}
+	return &hashMap[K, V]{entries: make(map[K]V), equal: equal}
+}
+
+// NewComparableHashMap returns an empty Map backed by a Go map that compares
+// values using the == operator.
+func NewComparableHashMap[K, V comparable]() Map[K, V] {
+	return NewHashMapFunc[K](base.Equal[V])
+}
+
+func (m *hashMap[K, V]) Put(key K, value V) (*V, bool) {
+	previous, ok := m.entries[key]
+	m.entries[key] = value
+	if !ok {
+		return nil, false
+	}
+	return &previous, true
+}
+
+func (m *hashMap[K, V]) Get(key K) (*V, bool) {
+	value, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	return &value, true
+}
+
+func (m *hashMap[K, V]) Remove(key K) (*V, bool) {
+	value, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	delete(m.entries, key)
+	return &value, true
+}
+
+func (m *hashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+func (m *hashMap[K, V]) ContainsValue(value V) bool {
+	return containsValue[K, V](m, value, m.equal)
+}
+
+func (m *hashMap[K, V]) Keys() set.Set[K] {
+	return &keyView[K, V]{m: m, newSet: set.NewHashSet[K]}
+}
+
+func (m *hashMap[K, V]) Values() base.Collection[V] {
+	return &valueView[K, V]{m: m}
+}
+
+func (m *hashMap[K, V]) Entries() []Entry[K, V] {
+	return entries[K, V](m)
+}
+
+func (m *hashMap[K, V]) ComputeIfAbsent(key K, compute func(key K) V) V {
+	return computeIfAbsent[K, V](m, key, compute)
+}
+
+func (m *hashMap[K, V]) Merge(key K, value V, merge func(current, value V) V) V {
+	return mergeValue[K, V](m, key, value, merge)
+}
+
+func (m *hashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m.entries {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *hashMap[K, V]) Clear() {
+	clear(m.entries)
+}
+
+func (m *hashMap[K, V]) IsEmpty() bool {
+	return len(m.entries) == 0
+}
+
+func (m *hashMap[K, V]) Size() int {
+	return len(m.entries)
+}
+
+func (m *hashMap[K, V]) String() string {
+	return format[K, V]("HashMap", m)
+}
+
+func (m *hashMap[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[K, V](m)
+}
+
+func (m *hashMap[K, V]) UnmarshalJSON(data []byte) error {
+	entries, err := unmarshalJSON[K, V](data)
+	if err != nil {
+		return err
+	}
+	replace[K, V](m, entries)
+	return nil
+}
+
+func (m *hashMap[K, V]) MarshalBinary() ([]byte, error) {
+	return marshalBinary[K, V](m)
+}
+
+func (m *hashMap[K, V]) UnmarshalBinary(data []byte) error {
+	entries, err := unmarshalBinary[K, V](data)
+	if err != nil {
+		return err
+	}
+	replace[K, V](m, entries)
+	return nil
+}
+
+func (m *hashMap[K, V]) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+func (m *hashMap[K, V]) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
@@ -0,0 +1,264 @@
+package maps
+
+import (
+	"encoding/json"
+	"hash/maphash"
+	"slices"
+	"testing"
+
+	"github.com/elias8/go-gather/set"
+)
+
+func TestNewHashMap(t *testing.T) {
+	m := NewHashMap[string, int]()
+	if m == nil {
+		t.Fatalf("Expected NewHashMap() to return a Map, got nil")
+	}
+	if !m.IsEmpty() || m.Size() != 0 {
+		t.Fatalf("Expected NewHashMap() to return an empty Map")
+	}
+}
+
+func TestHashMap_Put(t *testing.T) {
+	m := NewHashMap[string, int]()
+	if _, replaced := m.Put("a", 1); replaced {
+		t.Fatalf("Expected first Put to not replace a value")
+	}
+	previous, replaced := m.Put("a", 2)
+	if !replaced || *previous != 1 {
+		t.Fatalf("Expected second Put to replace 1")
+	}
+	if value, ok := m.Get("a"); !ok || *value != 2 {
+		t.Fatalf("Expected a to map to 2")
+	}
+	if m.Size() != 1 {
+		t.Fatalf("Expected size 1, got %d", m.Size())
+	}
+}
+
+func TestHashMap_Remove(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	if removed, ok := m.Remove("a"); !ok || *removed != 1 {
+		t.Fatalf("Expected Remove to return 1")
+	}
+	if _, ok := m.Remove("a"); ok {
+		t.Fatalf("Expected a to be removed only once")
+	}
+	if m.ContainsKey("a") {
+		t.Fatalf("Expected map to not contain a")
+	}
+	if _, ok := m.Get("a"); ok {
+		t.Fatalf("Expected Get to not find a")
+	}
+}
+
+func TestHashMap_Contains(t *testing.T) {
+	m := NewHashMap[string, []int]()
+	m.Put("a", []int{1, 2})
+	if !m.ContainsKey("a") || m.ContainsKey("b") {
+		t.Fatalf("Expected map to contain key a only")
+	}
+	if !m.ContainsValue([]int{1, 2}) || m.ContainsValue([]int{2}) {
+		t.Fatalf("Expected map to contain value [1 2] only")
+	}
+
+	byLength := NewHashMapFunc[string](func(a, b []int) bool { return len(a) == len(b) })
+	byLength.Put("a", []int{1, 2})
+	if !byLength.ContainsValue([]int{3, 4}) || byLength.ContainsValue([]int{1}) {
+		t.Fatalf("Expected map to compare values by length")
+	}
+	if !NewComparableHashMap[string, int]().Values().IsEmpty() {
+		t.Fatalf("Expected a new comparable map to have no values")
+	}
+}
+
+func TestHashMap_Views(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	keys := m.Keys()
+	if keys.Size() != 2 || !keys.Contains("a") || !keys.Contains("b") {
+		t.Fatalf("Expected keys [a b], got %v", keys.Values())
+	}
+	values := m.Values().Values()
+	slices.Sort(values)
+	if !slices.Equal(values, []int{1, 2}) {
+		t.Fatalf("Expected values [1 2], got %v", values)
+	}
+	entries := m.Entries()
+	slices.SortFunc(entries, func(a, b Entry[string, int]) int { return a.Value - b.Value })
+	if !slices.Equal(entries, []Entry[string, int]{{"a", 1}, {"b", 2}}) {
+		t.Fatalf("Expected entries [a=1 b=2], got %v", entries)
+	}
+
+	m.Put("c", 3)
+	if keys.Size() != 3 || !keys.Contains("c") || !m.Values().Contains(3) {
+		t.Fatalf("Expected the views to reflect a later Put, got %v", keys.Values())
+	}
+	for it := keys.Iterator(); it.HasNext(); {
+		if k, _ := it.Next(); *k == "a" {
+			it.Remove()
+		}
+	}
+	if m.ContainsKey("a") || m.Size() != 2 {
+		t.Fatalf("Expected removing a key through the view to remove it from the map")
+	}
+	for it := m.Values().Iterator(); it.HasNext(); {
+		if v, _ := it.Next(); *v == 2 {
+			it.Remove()
+		}
+	}
+	if m.ContainsKey("b") || m.Size() != 1 {
+		t.Fatalf("Expected removing a value through the view to remove its entry from the map")
+	}
+	m.Values().Clear()
+	if !m.IsEmpty() || !keys.IsEmpty() {
+		t.Fatalf("Expected clearing a view to clear the map")
+	}
+}
+
+func TestHashMap_KeySet(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	keys := m.Keys()
+
+	other := set.NewHashSet[string]()
+	other.Add("b")
+	other.Add("c")
+	if union := keys.Union(other); union.Size() != 3 || !union.ContainsAll("a", "b", "c") {
+		t.Fatalf("Expected union [a b c], got %v", union.Values())
+	}
+	if inter := keys.Intersection(other); !slices.Equal(inter.Values(), []string{"b"}) {
+		t.Fatalf("Expected intersection [b], got %v", inter.Values())
+	}
+	if diff := keys.Difference(other); !slices.Equal(diff.Values(), []string{"a"}) {
+		t.Fatalf("Expected difference [a], got %v", diff.Values())
+	}
+	if sym := keys.SymmetricDifference(other); sym.Size() != 2 || !sym.ContainsAll("a", "c") {
+		t.Fatalf("Expected symmetric difference [a c], got %v", sym.Values())
+	}
+	if keys.IsSubsetOf(other) {
+		t.Fatal("Expected [a b] not to be a subset of [b c]")
+	}
+
+	other.Add("a")
+	if !keys.IsSubsetOf(other) || keys.Equals(other) {
+		t.Fatal("Expected [a b] to be a proper subset of [a b c]")
+	}
+	other.Remove("c")
+	if !keys.Equals(other) || !other.Equals(keys) {
+		t.Fatal("Expected the keys to equal the set [a b] both ways")
+	}
+	seed := maphash.MakeSeed()
+	if keys.Hash(seed) != other.Hash(seed) {
+		t.Fatal("Expected the keys to hash like an equal set")
+	}
+
+	if !keys.Remove("a") || keys.Remove("a") || m.ContainsKey("a") {
+		t.Fatal("Expected removing a key from the view to remove it from the map once")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected adding a key to the view to panic")
+		}
+	}()
+	keys.Add("d")
+}
+
+func TestHashMap_ComputeIfAbsent(t *testing.T) {
+	m := NewHashMap[string, int]()
+	calls := 0
+	compute := func(key string) int {
+		calls++
+		return len(key)
+	}
+	if value := m.ComputeIfAbsent("abc", compute); value != 3 {
+		t.Fatalf("Expected computed value 3, got %d", value)
+	}
+	if value := m.ComputeIfAbsent("abc", compute); value != 3 {
+		t.Fatalf("Expected existing value 3, got %d", value)
+	}
+	if calls != 1 {
+		t.Fatalf("Expected compute to be called once, got %d", calls)
+	}
+}
+
+func TestHashMap_Merge(t *testing.T) {
+	m := NewHashMap[string, int]()
+	sum := func(current, value int) int { return current + value }
+	if value := m.Merge("a", 1, sum); value != 1 {
+		t.Fatalf("Expected merged value 1, got %d", value)
+	}
+	if value := m.Merge("a", 2, sum); value != 3 {
+		t.Fatalf("Expected merged value 3, got %d", value)
+	}
+	if value, _ := m.Get("a"); *value != 3 {
+		t.Fatalf("Expected a to map to 3, got %d", *value)
+	}
+}
+
+func TestHashMap_Clear(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	m.Clear()
+	if !m.IsEmpty() {
+		t.Fatalf("Expected map to be empty after clearing")
+	}
+}
+
+func TestHashMap_String(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+	if m.String() != "HashMap({a: 1})" {
+		t.Fatalf("Expected HashMap({a: 1}), got %s", m.String())
+	}
+}
+
+func TestHashMap_JSON(t *testing.T) {
+	m := NewHashMap[string, int]()
+	m.Put("a", 1)
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if string(data) != `{"a":1}` {
+		t.Fatalf(`Expected map to be encoded as {"a":1}, but got %s`, data)
+	}
+
+	decoded := NewHashMap[string, int]()
+	decoded.Put("b", 2)
+	if err := json.Unmarshal([]byte(`{"a":1,"c":3}`), decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if decoded.Size() != 2 || decoded.ContainsKey("b") {
+		t.Fatalf("Expected decoding to replace the entries, but got %v", decoded)
+	}
+	if val, _ := decoded.Get("c"); *val != 3 {
+		t.Fatalf("Expected c to map to 3, but got %v", *val)
+	}
+	if err := json.Unmarshal([]byte(`[1]`), decoded); err == nil {
+		t.Fatalf("Expected an error decoding an array into a map with string keys")
+	}
+}
+
+func TestHashMap_Binary(t *testing.T) {
+	m := NewHashMap[int, []string]()
+	m.Put(1, []string{"a"})
+	m.Put(2, nil)
+
+	data, err := m.(*hashMap[int, []string]).MarshalBinary()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	decoded := NewHashMap[int, []string]()
+	if err := decoded.(*hashMap[int, []string]).UnmarshalBinary(data); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if val, _ := decoded.Get(1); decoded.Size() != 2 || !slices.Equal(*val, []string{"a"}) {
+		t.Fatalf("Expected decoded map to match %v, but got %v", m, decoded)
+	}
+}
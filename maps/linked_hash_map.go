@@ -0,0 +1,164 @@
+package maps
+
+import (
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/linked"
+	"github.com/elias8/go-gather/list"
+	"github.com/elias8/go-gather/set"
+)
+
+// linkedHashMap is a Go map from the keys to the elements of a linked list of
+// entries, which remembers the order in which the keys were inserted.
+type linkedHashMap[K comparable, V any] struct {
+	entries map[K]*list.Element[Entry[K, V]]
+	order   *linked.List[Entry[K, V]]
+	equal   func(a, b V) bool
+}
+
+// NewLinkedHashMap returns an empty Map that iterates over its entries in the
+// order in which their keys were first inserted. Replacing the value of a key
+// does not change its position. Values are compared using reflect.DeepEqual.
+func NewLinkedHashMap[K comparable, V any]() Map[K, V] {
+	return NewLinkedHashMapFunc[K](base.DeepEqual[V])
+}
+
+// NewLinkedHashMapFunc returns an empty insertion-ordered Map that compares
+// values using the given equal function. If equal is nil, reflect.DeepEqual
+// is used.
+func NewLinkedHashMapFunc[K comparable, V any](equal func(a, b V) bool) Map[K, V] {
+	if equal == nil {
+		equal = base.DeepEqual[V]
+	}
+	return &linkedHashMap[K, V]{
+		entries: make(map[K]*list.Element[Entry[K, V]]),
+		order:   linked.New[Entry[K, V]](),
+		equal:   equal,
+	}
+}
+
+// NewComparableLinkedHashMap returns an empty insertion-ordered Map that
+// compares values using the == operator.
+func NewComparableLinkedHashMap[K, V comparable]() Map[K, V] {
+	return NewLinkedHashMapFunc[K](base.Equal[V])
+}
+
+func (m *linkedHashMap[K, V]) Put(key K, value V) (*V, bool) {
+	if e, ok := m.entries[key]; ok {
+		previous := e.Value.Value
+		e.Value.Value = value
+		return &previous, true
+	}
+	m.entries[key] = m.order.PushBack(Entry[K, V]{Key: key, Value: value})
+	return nil, false
+}
+
+func (m *linkedHashMap[K, V]) Get(key K) (*V, bool) {
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	value := e.Value.Value
+	return &value, true
+}
+
+func (m *linkedHashMap[K, V]) Remove(key K) (*V, bool) {
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	m.order.Remove(e)
+	delete(m.entries, key)
+	return &e.Value.Value, true
+}
+
+func (m *linkedHashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+func (m *linkedHashMap[K, V]) ContainsValue(value V) bool {
+	return containsValue[K, V](m, value, m.equal)
+}
+
+func (m *linkedHashMap[K, V]) Keys() set.Set[K] {
+	return &keyView[K, V]{m: m, newSet: set.NewLinkedHashSet[K]}
+}
+
+func (m *linkedHashMap[K, V]) Values() base.Collection[V] {
+	return &valueView[K, V]{m: m}
+}
+
+func (m *linkedHashMap[K, V]) Entries() []Entry[K, V] {
+	return entries[K, V](m)
+}
+
+func (m *linkedHashMap[K, V]) ComputeIfAbsent(key K, compute func(key K) V) V {
+	return computeIfAbsent[K, V](m, key, compute)
+}
+
+func (m *linkedHashMap[K, V]) Merge(key K, value V, merge func(current, value V) V) V {
+	return mergeValue[K, V](m, key, value, merge)
+}
+
+func (m *linkedHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := range m.order.All() {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+func (m *linkedHashMap[K, V]) Clear() {
+	clear(m.entries)
+	m.order.Clear()
+}
+
+func (m *linkedHashMap[K, V]) IsEmpty() bool {
+	return len(m.entries) == 0
+}
+
+func (m *linkedHashMap[K, V]) Size() int {
+	return len(m.entries)
+}
+
+func (m *linkedHashMap[K, V]) String() string {
+	return format[K, V]("LinkedHashMap", m)
+}
+
+func (m *linkedHashMap[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[K, V](m)
+}
+
+func (m *linkedHashMap[K, V]) UnmarshalJSON(data []byte) error {
+	entries, err := unmarshalJSON[K, V](data)
+	if err != nil {
+		return err
+	}
+	replace[K, V](m, entries)
+	return nil
+}
+
+func (m *linkedHashMap[K, V]) MarshalBinary() ([]byte, error) {
+	return marshalBinary[K, V](m)
+}
+
+func (m *linkedHashMap[K, V]) UnmarshalBinary(data []byte) error {
+	entries, err := unmarshalBinary[K, V](data)
+	if err != nil {
+		return err
+	}
+	replace[K, V](m, entries)
+	return nil
+}
+
+func (m *linkedHashMap[K, V]) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+func (m *linkedHashMap[K, V]) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
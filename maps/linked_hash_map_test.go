@@ -0,0 +1,189 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/elias8/go-gather/set"
+)
+
+func TestNewLinkedHashMap(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	if !m.IsEmpty() || m.Size() != 0 {
+		t.Fatalf("Expected NewLinkedHashMap() to return an empty Map")
+	}
+}
+
+func TestLinkedHashMap_InsertionOrder(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	m.Put("c", 1)
+	m.Put("a", 2)
+	m.Put("b", 3)
+	m.Put("c", 4)
+
+	if keys := m.Keys().Values(); !slices.Equal(keys, []string{"c", "a", "b"}) {
+		t.Fatalf("Expected keys [c a b], got %v", keys)
+	}
+	if values := m.Values().Values(); !slices.Equal(values, []int{4, 2, 3}) {
+		t.Fatalf("Expected values [4 2 3], got %v", values)
+	}
+	if s := m.Keys().String(); s != "MapKeys([c, a, b])" {
+		t.Fatalf("Expected MapKeys([c, a, b]), got %s", s)
+	}
+	if m.String() != "LinkedHashMap({c: 4, a: 2, b: 3})" {
+		t.Fatalf("Expected LinkedHashMap({c: 4, a: 2, b: 3}), got %s", m.String())
+	}
+}
+
+func TestLinkedHashMap_KeySet(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	other := set.NewHashSet[string]()
+	other.Add("d")
+	if keys := m.Keys().Union(other).Values(); !slices.Equal(keys, []string{"c", "a", "b", "d"}) {
+		t.Fatalf("Expected union [c a b d] in insertion order, got %v", keys)
+	}
+}
+
+func TestLinkedHashMap_Remove(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		key      string
+		removed  bool
+		expected []string
+	}{
+		{name: "remove head", key: "a", removed: true, expected: []string{"b", "c"}},
+		{name: "remove middle", key: "b", removed: true, expected: []string{"a", "c"}},
+		{name: "remove tail", key: "c", removed: true, expected: []string{"a", "b"}},
+		{name: "remove missing", key: "d", removed: false, expected: []string{"a", "b", "c"}},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			m := NewLinkedHashMap[string, int]()
+			m.Put("a", 1)
+			m.Put("b", 2)
+			m.Put("c", 3)
+			if _, removed := m.Remove(s.key); removed != s.removed {
+				t.Fatalf("Expected Remove(%s) to return %v", s.key, s.removed)
+			}
+			var keys []string
+			for k := range m.All() {
+				keys = append(keys, k)
+			}
+			if !slices.Equal(keys, s.expected) {
+				t.Fatalf("Expected keys %v, got %v", s.expected, keys)
+			}
+		})
+	}
+}
+
+func TestLinkedHashMap_RemoveWhileRanging(t *testing.T) {
+	m := NewLinkedHashMap[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	m.Put(3, "c")
+
+	var keys []int
+	for k := range m.All() {
+		keys = append(keys, k)
+		if k == 1 {
+			m.Remove(2)
+		}
+	}
+	if !slices.Equal(keys, []int{1, 3}) {
+		t.Fatalf("Expected removing the next key while ranging to skip it, got %v", keys)
+	}
+	for k := range m.All() {
+		m.Remove(k)
+	}
+	if !m.IsEmpty() {
+		t.Fatalf("Expected removing every key while ranging to empty the map, got %v", m)
+	}
+}
+
+func TestLinkedHashMap_ComputeAndMerge(t *testing.T) {
+	m := NewLinkedHashMap[string, []string]()
+	m.ComputeIfAbsent("x", func(string) []string { return []string{"first"} })
+	m.Merge("x", []string{"second"}, func(current, value []string) []string {
+		return append(current, value...)
+	})
+	m.Merge("y", []string{"third"}, func(current, value []string) []string {
+		t.Fatalf("Expected merge to not be called for an absent key")
+		return nil
+	})
+
+	expected := []Entry[string, []string]{{"x", []string{"first", "second"}}, {"y", []string{"third"}}}
+	entries := m.Entries()
+	if len(entries) != len(expected) {
+		t.Fatalf("Expected %d entries, got %d", len(expected), len(entries))
+	}
+	for i := range entries {
+		if entries[i].Key != expected[i].Key || !slices.Equal(entries[i].Value, expected[i].Value) {
+			t.Fatalf("Expected entry %v, got %v", expected[i], entries[i])
+		}
+	}
+	if !m.ContainsValue([]string{"third"}) {
+		t.Fatalf("Expected map to contain value [third]")
+	}
+}
+
+func TestLinkedHashMap_Clear(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	m.Put("a", 1)
+	m.Clear()
+	if !m.IsEmpty() || m.Entries() != nil {
+		t.Fatalf("Expected map to be empty after clearing")
+	}
+	m.Put("b", 2)
+	if keys := m.Keys().Values(); !slices.Equal(keys, []string{"b"}) {
+		t.Fatalf("Expected keys [b], got %v", keys)
+	}
+}
+
+func TestLinkedHashMap_JSON(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	m.Put("c", 1)
+	m.Put("a", 2)
+	m.Put("b", 3)
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if string(data) != `{"c":1,"a":2,"b":3}` {
+		t.Fatalf(`Expected map to be encoded in insertion order as {"c":1,"a":2,"b":3}, but got %s`, data)
+	}
+
+	decoded := NewLinkedHashMap[string, int]()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if keys := slices.Collect(decoded.Keys().All()); !slices.Equal(keys, []string{"c", "a", "b"}) {
+		t.Fatalf("Expected decoded keys in the order [c a b], but got %v", keys)
+	}
+}
+
+func TestLinkedHashMap_Gob(t *testing.T) {
+	m := NewLinkedHashMap[int, string]()
+	m.Put(3, "c")
+	m.Put(1, "a")
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(m); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	decoded := NewLinkedHashMap[int, string]()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if entries := decoded.Entries(); !slices.Equal(entries, []Entry[int, string]{{3, "c"}, {1, "a"}}) {
+		t.Fatalf("Expected decoded entries [{3 c} {1 a}], but got %v", entries)
+	}
+}
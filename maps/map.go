@@ -0,0 +1,177 @@
+package maps
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/set"
+)
+
+// Entry is a key-value pair stored in a Map.
+type Entry[K, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+// Map is a collection of key-value pairs where each key maps to at most one
+// value. Values are compared using the equality the map was created with,
+// which is reflect.DeepEqual unless a constructor says otherwise.
+type Map[K, V any] interface {
+	// Put associates the value with the key. Returns the previous value and
+	// true if the key was already present, nil and false otherwise.
+	Put(key K, value V) (*V, bool)
+
+	// Get returns a pointer to a copy of the value associated with the key,
+	// so modifying it does not affect the map; use Put to replace the value.
+	// Returns nil and false if the map does not contain the key.
+	Get(key K) (*V, bool)
+
+	// Remove removes the key and its value from the map. Returns the removed
+	// value and true if the key was present, nil and false otherwise.
+	Remove(key K) (*V, bool)
+
+	// ContainsKey returns true if the map contains the key.
+	ContainsKey(key K) bool
+
+	// ContainsValue returns true if one or more keys map to the value.
+	ContainsValue(value V) bool
+
+	// Keys returns a set view of the keys of the map, in the iteration order
+	// of the map. The view is backed by the map, so changes to the map are
+	// reflected in the view, and removing a key from the view, through its
+	// iterator or by clearing it removes the entries from the map. Adding a
+	// key to the view panics. The set operations of the view return sets of
+	// the kind matching the map: a HashSet, LinkedHashSet or TreeSet.
+	Keys() set.Set[K]
+
+	// Values returns a view of the values of the map, in the iteration order
+	// of the map, compared using the equality of the map. The view is backed
+	// by the map, so changes to the map are reflected in the view, and
+	// removing a value through the iterator of the view or clearing the view
+	// removes the entries from the map.
+	Values() base.Collection[V]
+
+	// Entries returns the key-value pairs of the map, in the iteration order
+	// of the map.
+	Entries() []Entry[K, V]
+
+	// ComputeIfAbsent returns the value associated with the key. If the key is
+	// not present, the value is computed using compute, stored in the map and
+	// returned.
+	ComputeIfAbsent(key K, compute func(key K) V) V
+
+	// Merge associates the value with the key if the key is not present.
+	// Otherwise, replaces the current value with the result of calling merge
+	// with the current value and the given value. Returns the new value.
+	Merge(key K, value V, merge func(current, value V) V) V
+
+	// All returns an iterator over the key-value pairs of the map, in the
+	// iteration order of the map.
+	All() iter.Seq2[K, V]
+
+	// Clear removes all entries from the map.
+	Clear()
+
+	// IsEmpty returns true if the map contains no entries.
+	IsEmpty() bool
+
+	// Size returns the number of entries in the map.
+	Size() int
+
+	// String returns string representation of the map.
+	String() string
+}
+
+// SortedMap is a Map whose keys are kept in ascending order according to a
+// comparison function.
+//
+// FirstKey, LastKey, FloorKey, CeilingKey, LowerKey and HigherKey return a
+// pointer to a copy of the key, so that it is not changed by later
+// modifications of the map, and modifying it does not affect the map.
+type SortedMap[K, V any] interface {
+	Map[K, V]
+
+	// FirstKey returns the lowest key in the map. Returns nil and false if the
+	// map is empty.
+	FirstKey() (*K, bool)
+
+	// LastKey returns the highest key in the map. Returns nil and false if the
+	// map is empty.
+	LastKey() (*K, bool)
+
+	// FloorKey returns the greatest key less than or equal to the specified
+	// key. Returns nil and false if there is no such key.
+	FloorKey(key K) (*K, bool)
+
+	// CeilingKey returns the least key greater than or equal to the specified
+	// key. Returns nil and false if there is no such key.
+	CeilingKey(key K) (*K, bool)
+
+	// LowerKey returns the greatest key strictly less than the specified key.
+	// Returns nil and false if there is no such key.
+	LowerKey(key K) (*K, bool)
+
+	// HigherKey returns the least key strictly greater than the specified key.
+	// Returns nil and false if there is no such key.
+	HigherKey(key K) (*K, bool)
+
+	// HeadMap returns a new map containing the entries whose keys are strictly
+	// less than to.
+	HeadMap(to K) SortedMap[K, V]
+
+	// TailMap returns a new map containing the entries whose keys are greater
+	// than or equal to from.
+	TailMap(from K) SortedMap[K, V]
+
+	// SubMap returns a new map containing the entries whose keys range from
+	// from, inclusive, to to, exclusive.
+	SubMap(from, to K) SortedMap[K, V]
+}
+
+func computeIfAbsent[K, V any](m Map[K, V], key K, compute func(key K) V) V {
+	if value, ok := m.Get(key); ok {
+		return *value
+	}
+	value := compute(key)
+	m.Put(key, value)
+	return value
+}
+
+func mergeValue[K, V any](m Map[K, V], key K, value V, merge func(current, value V) V) V {
+	if current, ok := m.Get(key); ok {
+		value = merge(*current, value)
+	}
+	m.Put(key, value)
+	return value
+}
+
+func containsValue[K, V any](m Map[K, V], value V, equal func(a, b V) bool) bool {
+	for _, v := range m.All() {
+		if equal(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func entries[K, V any](m Map[K, V]) []Entry[K, V] {
+	var entries []Entry[K, V]
+	for k, v := range m.All() {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+	return entries
+}
+
+func format[K, V any](name string, m Map[K, V]) string {
+	str := name + "({"
+	i := 0
+	for k, v := range m.All() {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v: %v", k, v)
+		i++
+	}
+	return str + "})"
+}
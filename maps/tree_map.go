@@ -0,0 +1,241 @@
+package maps
+
+import (
+	"cmp"
+	"hash/maphash"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/set"
+	"github.com/elias8/go-gather/tree"
+)
+
+// treeMap keeps its entries in a red-black tree ordered by key, which gives
+// O(log n) lookups, insertions and removals. hash hashes the keys in the sets
+// returned by the key view, and is nil when the keys are not comparable.
+type treeMap[K, V any] struct {
+	tree    tree.Tree[Entry[K, V]]
+	compare func(a, b K) int
+	hash    func(seed maphash.Seed, k K) uint64
+	equal   func(a, b V) bool
+}
+
+// NewTreeMap returns an empty SortedMap that orders its keys using their
+// natural ordering. Values are compared using reflect.DeepEqual.
+func NewTreeMap[K cmp.Ordered, V any]() SortedMap[K, V] {
+	return newTreeMap[K, V](cmp.Compare[K], base.Hash[K], base.DeepEqual[V])
+}
+
+// NewTreeMapFunc returns an empty SortedMap that orders its keys using the
+// given compare function, which returns a negative number when a < b, a
+// positive number when a > b and zero when a == b, and compares values using
+// the given equal function. Two keys are considered equal if compare returns
+// zero. If equal is nil, reflect.DeepEqual is used.
+func NewTreeMapFunc[K, V any](compare func(a, b K) int, equal func(a, b V) bool) SortedMap[K, V] {
+	if equal == nil {
+		equal = base.DeepEqual[V]
+	}
+	return newTreeMap(compare, nil, equal)
+}
+
+// NewComparableTreeMap returns an empty SortedMap that orders its keys using
+// their natural ordering and compares values using the == operator.
+func NewComparableTreeMap[K cmp.Ordered, V comparable]() SortedMap[K, V] {
+	return newTreeMap[K, V](cmp.Compare[K], base.Hash[K], base.Equal[V])
+}
+
+func newTreeMap[K, V any](compare func(a, b K) int, hash func(seed maphash.Seed, k K) uint64, equal func(a, b V) bool) *treeMap[K, V] {
+	return &treeMap[K, V]{
+		tree: tree.NewRedBlackFunc(func(a, b Entry[K, V]) int {
+			return compare(a.Key, b.Key)
+		}),
+		compare: compare,
+		hash:    hash,
+		equal:   equal,
+	}
+}
+
+func (m *treeMap[K, V]) Put(key K, value V) (*V, bool) {
+	if e, found := m.search(key); found {
+		// The tree returns copies of its entries, so the entry is replaced,
+		// keeping the key it was stored with.
+		previous := e.Value
+		m.tree.Insert(Entry[K, V]{Key: e.Key, Value: value})
+		return &previous, true
+	}
+	m.tree.Insert(Entry[K, V]{Key: key, Value: value})
+	return nil, false
+}
+
+func (m *treeMap[K, V]) Get(key K) (*V, bool) {
+	e, found := m.search(key)
+	if !found {
+		return nil, false
+	}
+	return &e.Value, true
+}
+
+func (m *treeMap[K, V]) Remove(key K) (*V, bool) {
+	e, found := m.search(key)
+	if !found {
+		return nil, false
+	}
+	removed := e.Value
+	m.tree.Delete(*e)
+	return &removed, true
+}
+
+func (m *treeMap[K, V]) ContainsKey(key K) bool {
+	_, found := m.search(key)
+	return found
+}
+
+func (m *treeMap[K, V]) ContainsValue(value V) bool {
+	return containsValue[K, V](m, value, m.equal)
+}
+
+func (m *treeMap[K, V]) Keys() set.Set[K] {
+	return &keyView[K, V]{m: m, newSet: func() set.Set[K] {
+		return set.NewTreeSetFunc(m.compare, m.hash)
+	}}
+}
+
+func (m *treeMap[K, V]) Values() base.Collection[V] {
+	return &valueView[K, V]{m: m}
+}
+
+func (m *treeMap[K, V]) Entries() []Entry[K, V] {
+	return entries[K, V](m)
+}
+
+func (m *treeMap[K, V]) ComputeIfAbsent(key K, compute func(key K) V) V {
+	return computeIfAbsent[K, V](m, key, compute)
+}
+
+func (m *treeMap[K, V]) Merge(key K, value V, merge func(current, value V) V) V {
+	return mergeValue[K, V](m, key, value, merge)
+}
+
+func (m *treeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := range m.tree.All() {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+func (m *treeMap[K, V]) Clear() {
+	m.tree.Clear()
+}
+
+func (m *treeMap[K, V]) IsEmpty() bool {
+	return m.tree.IsEmpty()
+}
+
+func (m *treeMap[K, V]) Size() int {
+	return m.tree.Size()
+}
+
+func (m *treeMap[K, V]) String() string {
+	return format[K, V]("TreeMap", m)
+}
+
+func (m *treeMap[K, V]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[K, V](m)
+}
+
+func (m *treeMap[K, V]) UnmarshalJSON(data []byte) error {
+	entries, err := unmarshalJSON[K, V](data)
+	if err != nil {
+		return err
+	}
+	replace[K, V](m, entries)
+	return nil
+}
+
+func (m *treeMap[K, V]) MarshalBinary() ([]byte, error) {
+	return marshalBinary[K, V](m)
+}
+
+func (m *treeMap[K, V]) UnmarshalBinary(data []byte) error {
+	entries, err := unmarshalBinary[K, V](data)
+	if err != nil {
+		return err
+	}
+	replace[K, V](m, entries)
+	return nil
+}
+
+func (m *treeMap[K, V]) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+func (m *treeMap[K, V]) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
+
+func (m *treeMap[K, V]) FirstKey() (*K, bool) {
+	return keyOf(m.tree.Min())
+}
+
+func (m *treeMap[K, V]) LastKey() (*K, bool) {
+	return keyOf(m.tree.Max())
+}
+
+func (m *treeMap[K, V]) FloorKey(key K) (*K, bool) {
+	return keyOf(m.tree.Floor(Entry[K, V]{Key: key}))
+}
+
+func (m *treeMap[K, V]) CeilingKey(key K) (*K, bool) {
+	return keyOf(m.tree.Ceiling(Entry[K, V]{Key: key}))
+}
+
+func (m *treeMap[K, V]) LowerKey(key K) (*K, bool) {
+	return keyOf(m.tree.Predecessor(Entry[K, V]{Key: key}))
+}
+
+func (m *treeMap[K, V]) HigherKey(key K) (*K, bool) {
+	return keyOf(m.tree.Successor(Entry[K, V]{Key: key}))
+}
+
+func (m *treeMap[K, V]) HeadMap(to K) SortedMap[K, V] {
+	first, ok := m.tree.Min()
+	return m.copyRange(first, ok, &to)
+}
+
+func (m *treeMap[K, V]) TailMap(from K) SortedMap[K, V] {
+	first, ok := m.tree.Ceiling(Entry[K, V]{Key: from})
+	return m.copyRange(first, ok, nil)
+}
+
+func (m *treeMap[K, V]) SubMap(from, to K) SortedMap[K, V] {
+	first, ok := m.tree.Ceiling(Entry[K, V]{Key: from})
+	return m.copyRange(first, ok, &to)
+}
+
+// search returns the entry stored for key, and whether it is present in the
+// map.
+func (m *treeMap[K, V]) search(key K) (*Entry[K, V], bool) {
+	return m.tree.Search(Entry[K, V]{Key: key})
+}
+
+// copyRange returns a new map containing the entries from first, inclusive,
+// to the key to, exclusive. If to is nil, the range extends to the last entry.
+func (m *treeMap[K, V]) copyRange(first *Entry[K, V], ok bool, to *K) *treeMap[K, V] {
+	result := newTreeMap(m.compare, m.hash, m.equal)
+	for e := first; ok && (to == nil || m.compare(e.Key, *to) < 0); e, ok = m.tree.Successor(*e) {
+		result.tree.Insert(*e)
+	}
+	return result
+}
+
+// keyOf returns a pointer to the key of the entry, which is a copy returned by
+// the tree, so that the key is not shared with the map.
+func keyOf[K, V any](e *Entry[K, V], ok bool) (*K, bool) {
+	if !ok {
+		return nil, false
+	}
+	return &e.Key, true
+}
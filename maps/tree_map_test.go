@@ -0,0 +1,250 @@
+package maps
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"hash/maphash"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/elias8/go-gather/set"
+)
+
+func treeMapOf(keys ...int) SortedMap[int, string] {
+	m := NewTreeMap[int, string]()
+	for _, k := range keys {
+		m.Put(k, strings.Repeat("*", k))
+	}
+	return m
+}
+
+func TestNewTreeMap(t *testing.T) {
+	m := NewTreeMap[int, string]()
+	if !m.IsEmpty() || m.Size() != 0 {
+		t.Fatalf("Expected NewTreeMap() to return an empty Map")
+	}
+	if _, ok := m.FirstKey(); ok {
+		t.Fatalf("Expected empty map to have no first key")
+	}
+	if _, ok := m.LastKey(); ok {
+		t.Fatalf("Expected empty map to have no last key")
+	}
+}
+
+func TestNewTreeMapFunc(t *testing.T) {
+	m := NewTreeMapFunc[string, int](func(a, b string) int { return len(a) - len(b) }, nil)
+	m.Put("ccc", 3)
+	m.Put("a", 1)
+	if _, replaced := m.Put("b", 2); !replaced {
+		t.Fatalf("Expected b to replace a as keys of equal length")
+	}
+	if values := m.Values().Values(); !slices.Equal(values, []int{2, 3}) {
+		t.Fatalf("Expected values [2 3], got %v", values)
+	}
+}
+
+func TestTreeMap_Order(t *testing.T) {
+	m := treeMapOf(3, 1, 2)
+	keys := m.Keys()
+	if values := keys.Values(); !slices.Equal(values, []int{1, 2, 3}) {
+		t.Fatalf("Expected keys [1 2 3], got %v", values)
+	}
+	m.Put(0, "")
+	if values := keys.Values(); !slices.Equal(values, []int{0, 1, 2, 3}) {
+		t.Fatalf("Expected the key view to reflect the new key in order, got %v", values)
+	}
+	m.Remove(0)
+	if m.String() != "TreeMap({1: *, 2: **, 3: ***})" {
+		t.Fatalf("Expected TreeMap({1: *, 2: **, 3: ***}), got %s", m.String())
+	}
+	if first, _ := m.FirstKey(); *first != 1 {
+		t.Fatalf("Expected first key 1, got %d", *first)
+	}
+	if last, _ := m.LastKey(); *last != 3 {
+		t.Fatalf("Expected last key 3, got %d", *last)
+	}
+}
+
+func TestTreeMap_KeySet(t *testing.T) {
+	m := treeMapOf(3, 1, 2)
+
+	other := set.NewTreeSet[int]()
+	other.Add(0)
+	other.Add(2)
+	union := m.Keys().Union(other)
+	if _, ok := union.(set.SortedSet[int]); !ok {
+		t.Fatalf("Expected the union to be a SortedSet, got %T", union)
+	}
+	if keys := union.Values(); !slices.Equal(keys, []int{0, 1, 2, 3}) {
+		t.Fatalf("Expected union [0 1 2 3], got %v", keys)
+	}
+	if keys := m.Keys().Difference(other).Values(); !slices.Equal(keys, []int{1, 3}) {
+		t.Fatalf("Expected difference [1 3], got %v", keys)
+	}
+	seed := maphash.MakeSeed()
+	if m.Keys().Hash(seed) != m.Keys().Union(set.NewTreeSet[int]()).Hash(seed) {
+		t.Fatal("Expected the keys to hash like an equal set")
+	}
+
+	byLength := NewTreeMapFunc[string, int](func(a, b string) int { return len(a) - len(b) }, nil)
+	byLength.Put("a", 1)
+	byLength.Put("bb", 2)
+	z := set.NewHashSet[string]()
+	z.Add("z")
+	if union := byLength.Keys().Union(z); !slices.Equal(union.Values(), []string{"a", "bb"}) {
+		t.Fatalf("Expected the union to compare keys by length, got %v", union.Values())
+	}
+}
+
+func TestTreeMap_Navigation(t *testing.T) {
+	m := treeMapOf(10, 20, 30)
+	scenarios := []struct {
+		name     string
+		find     func(int) (*int, bool)
+		key      int
+		expected int
+		found    bool
+	}{
+		{name: "floor of present", find: m.FloorKey, key: 20, expected: 20, found: true},
+		{name: "floor of absent", find: m.FloorKey, key: 25, expected: 20, found: true},
+		{name: "floor below first", find: m.FloorKey, key: 5, found: false},
+		{name: "ceiling of absent", find: m.CeilingKey, key: 15, expected: 20, found: true},
+		{name: "ceiling above last", find: m.CeilingKey, key: 31, found: false},
+		{name: "lower of present", find: m.LowerKey, key: 20, expected: 10, found: true},
+		{name: "higher of present", find: m.HigherKey, key: 20, expected: 30, found: true},
+		{name: "higher of last", find: m.HigherKey, key: 30, found: false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			k, found := s.find(s.key)
+			if found != s.found {
+				t.Fatalf("Expected found to be %v", s.found)
+			}
+			if found && *k != s.expected {
+				t.Fatalf("Expected %d, got %d", s.expected, *k)
+			}
+		})
+	}
+}
+
+func TestTreeMap_QueriesReturnCopies(t *testing.T) {
+	m := treeMapOf(2, 1, 3)
+	floor, _ := m.FloorKey(2)
+	value, _ := m.Get(2)
+	m.Remove(2)
+	if *floor != 2 || *value != "**" {
+		t.Fatalf("Expected results to be kept after Remove(2), got %v and %v", *floor, *value)
+	}
+
+	first, _ := m.FirstKey()
+	*first = 100
+	if !m.ContainsKey(1) || m.ContainsKey(100) {
+		t.Fatalf("Expected modifying FirstKey to leave the map unchanged, got %v", m)
+	}
+	value, _ = m.Get(1)
+	*value = "changed"
+	if value, _ = m.Get(1); *value != "*" {
+		t.Fatalf("Expected modifying Get to leave the map unchanged, got %v", *value)
+	}
+}
+
+func TestTreeMap_Ranges(t *testing.T) {
+	m := treeMapOf(1, 2, 3, 4, 5)
+	keys := func(m SortedMap[int, string]) []int {
+		return m.Keys().Values()
+	}
+	if k := keys(m.HeadMap(3)); !slices.Equal(k, []int{1, 2}) {
+		t.Fatalf("Expected head map keys [1 2], got %v", k)
+	}
+	if k := keys(m.TailMap(3)); !slices.Equal(k, []int{3, 4, 5}) {
+		t.Fatalf("Expected tail map keys [3 4 5], got %v", k)
+	}
+	if k := keys(m.SubMap(2, 4)); !slices.Equal(k, []int{2, 3}) {
+		t.Fatalf("Expected sub map keys [2 3], got %v", k)
+	}
+	sub := m.SubMap(2, 4)
+	sub.Remove(2)
+	if !m.ContainsKey(2) {
+		t.Fatalf("Expected sub map to be independent of the map")
+	}
+}
+
+func TestTreeMap_Remove(t *testing.T) {
+	m := treeMapOf(1, 2, 3)
+	if removed, ok := m.Remove(2); !ok || *removed != "**" {
+		t.Fatalf("Expected Remove to return **")
+	}
+	if _, ok := m.Remove(2); ok {
+		t.Fatalf("Expected 2 to be removed only once")
+	}
+	if k := m.Keys().Values(); !slices.Equal(k, []int{1, 3}) {
+		t.Fatalf("Expected keys [1 3], got %v", k)
+	}
+	m.Clear()
+	if !m.IsEmpty() {
+		t.Fatalf("Expected map to be empty after clearing")
+	}
+}
+
+func TestTreeMap_ComputeAndMerge(t *testing.T) {
+	m := NewTreeMap[string, int]()
+	for _, word := range strings.Fields("b a b c b a") {
+		m.Merge(word, 1, func(current, value int) int { return current + value })
+	}
+	m.ComputeIfAbsent("d", func(string) int { return 0 })
+
+	expected := []Entry[string, int]{{"a", 2}, {"b", 3}, {"c", 1}, {"d", 0}}
+	if entries := m.Entries(); !slices.Equal(entries, expected) {
+		t.Fatalf("Expected %v, got %v", expected, entries)
+	}
+	if !m.ContainsValue(3) || m.ContainsValue(4) {
+		t.Fatalf("Expected map to contain value 3 and not 4")
+	}
+}
+
+func TestTreeMap_JSON(t *testing.T) {
+	m := treeMapOf(2, 1)
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	expected := `[{"key":1,"value":"*"},{"key":2,"value":"**"}]`
+	if string(data) != expected {
+		t.Fatalf("Expected map with int keys to be encoded as %v, but got %s", expected, data)
+	}
+
+	decoded := NewTreeMapFunc[int, string](func(a, b int) int { return b - a }, nil)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if keys := slices.Collect(decoded.Keys().All()); !slices.Equal(keys, []int{2, 1}) {
+		t.Fatalf("Expected decoded map to keep its order, but got %v", keys)
+	}
+
+	empty, _ := json.Marshal(NewTreeMap[int, string]())
+	if string(empty) != "[]" {
+		t.Fatalf("Expected empty map to be encoded as [], but got %s", empty)
+	}
+}
+
+func TestTreeMap_Gob(t *testing.T) {
+	m := NewTreeMap[string, int]()
+	m.Put("b", 2)
+	m.Put("a", 1)
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(m); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	decoded := NewTreeMap[string, int]()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if entries := decoded.Entries(); !slices.Equal(entries, []Entry[string, int]{{"a", 1}, {"b", 2}}) {
+		t.Fatalf("Expected decoded entries [{a 1} {b 2}], but got %v", entries)
+	}
+}
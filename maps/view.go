@@ -0,0 +1,227 @@
+package maps
+
+import (
+	"fmt"
+	"hash/maphash"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/set"
+)
+
+// keyView is the set of the keys of a map returned by Keys. It reads through
+// to the map, so it always reflects its current keys. newSet returns an empty
+// set of the kind matching the map, which holds the results of the set
+// operations.
+type keyView[K, V any] struct {
+	m      Map[K, V]
+	newSet func() set.Set[K]
+}
+
+func (v *keyView[K, V]) Contains(key K) bool {
+	return v.m.ContainsKey(key)
+}
+
+func (v *keyView[K, V]) Clear() {
+	v.m.Clear()
+}
+
+func (v *keyView[K, V]) IsEmpty() bool {
+	return v.m.IsEmpty()
+}
+
+func (v *keyView[K, V]) Size() int {
+	return v.m.Size()
+}
+
+func (v *keyView[K, V]) Values() []K {
+	keys := make([]K, 0, v.m.Size())
+	for k := range v.m.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (v *keyView[K, V]) String() string {
+	return formatView("MapKeys", v.All())
+}
+
+func (v *keyView[K, V]) Iterator() base.Iterator[K] {
+	entries := v.m.Entries()
+	return &viewIterator[K, V, K]{m: v.m, entries: entries, value: func(e *Entry[K, V]) *K { return &e.Key }}
+}
+
+func (v *keyView[K, V]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range v.m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Add panics, since a key cannot be added to a map without a value.
+func (v *keyView[K, V]) Add(K) bool {
+	panic("maps: cannot add a key without a value")
+}
+
+func (v *keyView[K, V]) Remove(key K) bool {
+	_, removed := v.m.Remove(key)
+	return removed
+}
+
+func (v *keyView[K, V]) ContainsAll(keys ...K) bool {
+	for _, k := range keys {
+		if !v.m.ContainsKey(k) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *keyView[K, V]) Union(other set.Set[K]) set.Set[K] {
+	return v.copy().Union(other)
+}
+
+func (v *keyView[K, V]) Intersection(other set.Set[K]) set.Set[K] {
+	return v.copy().Intersection(other)
+}
+
+func (v *keyView[K, V]) Difference(other set.Set[K]) set.Set[K] {
+	return v.copy().Difference(other)
+}
+
+func (v *keyView[K, V]) SymmetricDifference(other set.Set[K]) set.Set[K] {
+	return v.copy().SymmetricDifference(other)
+}
+
+func (v *keyView[K, V]) IsSubsetOf(other set.Set[K]) bool {
+	for k := range v.m.All() {
+		if !other.Contains(k) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *keyView[K, V]) Equals(other base.Collection[K]) bool {
+	o, ok := other.(set.Set[K])
+	if !ok || v.m.Size() != o.Size() {
+		return false
+	}
+	for k := range o.All() {
+		if !v.m.ContainsKey(k) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *keyView[K, V]) Hash(seed maphash.Seed) uint64 {
+	return v.copy().Hash(seed)
+}
+
+// copy returns a new set of the kind matching the map holding its keys.
+func (v *keyView[K, V]) copy() set.Set[K] {
+	keys := v.newSet()
+	for k := range v.m.All() {
+		keys.Add(k)
+	}
+	return keys
+}
+
+// valueView is the collection of the values of a map returned by Values. It
+// reads through to the map, so it always reflects its current values.
+type valueView[K, V any] struct {
+	m Map[K, V]
+}
+
+func (v *valueView[K, V]) Contains(value V) bool {
+	return v.m.ContainsValue(value)
+}
+
+func (v *valueView[K, V]) Clear() {
+	v.m.Clear()
+}
+
+func (v *valueView[K, V]) IsEmpty() bool {
+	return v.m.IsEmpty()
+}
+
+func (v *valueView[K, V]) Size() int {
+	return v.m.Size()
+}
+
+func (v *valueView[K, V]) Values() []V {
+	values := make([]V, 0, v.m.Size())
+	for _, value := range v.m.All() {
+		values = append(values, value)
+	}
+	return values
+}
+
+func (v *valueView[K, V]) String() string {
+	return formatView("MapValues", v.All())
+}
+
+func (v *valueView[K, V]) Iterator() base.Iterator[V] {
+	entries := v.m.Entries()
+	return &viewIterator[K, V, V]{m: v.m, entries: entries, value: func(e *Entry[K, V]) *V { return &e.Value }}
+}
+
+func (v *valueView[K, V]) All() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range v.m.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// viewIterator iterates over the entries a map held when the iterator was
+// created, yielding the part of each entry selected by value. Remove removes
+// the key of the last returned entry from the map.
+type viewIterator[K, V, T any] struct {
+	m       Map[K, V]
+	entries []Entry[K, V]
+	value   func(e *Entry[K, V]) *T
+	cursor  int
+	last    *Entry[K, V]
+}
+
+func (it *viewIterator[K, V, T]) HasNext() bool {
+	return it.cursor < len(it.entries)
+}
+
+func (it *viewIterator[K, V, T]) Next() (*T, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	it.last = &it.entries[it.cursor]
+	it.cursor++
+	return it.value(it.last), true
+}
+
+func (it *viewIterator[K, V, T]) Remove() bool {
+	if it.last == nil {
+		return false
+	}
+	_, removed := it.m.Remove(it.last.Key)
+	it.last = nil
+	return removed
+}
+
+func formatView[T any](name string, values iter.Seq[T]) string {
+	str := name + "(["
+	i := 0
+	for v := range values {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", v)
+		i++
+	}
+	return str + "])"
+}
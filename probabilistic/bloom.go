@@ -0,0 +1,187 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// BloomFilter is a set that may report elements it does not contain, but
+// never misses elements it does contain. It takes a fixed amount of memory
+// however many elements are added, and elements cannot be removed.
+type BloomFilter[T any] interface {
+	// Add adds the element to the filter.
+	Add(element T)
+
+	// MightContain returns false if the element was definitely not added to
+	// the filter, and true if it probably was.
+	MightContain(element T) bool
+
+	// ApproximateCount returns an estimate of the number of distinct elements
+	// added to the filter, derived from the number of bits set.
+	ApproximateCount() int
+
+	// FalsePositiveRate returns an estimate of the probability that
+	// MightContain returns true for an element that was not added, given the
+	// elements added so far.
+	FalsePositiveRate() float64
+
+	// Bits returns the number of bits of the filter.
+	Bits() int
+
+	// Hashes returns the number of bits each element sets.
+	Hashes() int
+
+	// Union adds the elements of other to the filter. It fails with
+	// ErrIncompatible unless other was created with the same number of bits
+	// and hashes.
+	Union(other BloomFilter[T]) error
+
+	// Intersect keeps in the filter only the elements that other might
+	// contain too. It fails with ErrIncompatible unless other was created with
+	// the same number of bits and hashes. The result may report more false
+	// positives than a filter built from the intersection itself.
+	Intersect(other BloomFilter[T]) error
+
+	// Clear removes all elements from the filter.
+	Clear()
+
+	// MarshalBinary encodes the filter so that UnmarshalBinary can restore
+	// it, possibly in another process.
+	MarshalBinary() ([]byte, error)
+
+	// UnmarshalBinary replaces the filter with the decoded one, including its
+	// number of bits and hashes. It fails with ErrInvalidEncoding if data was
+	// not produced by MarshalBinary of a Bloom filter.
+	UnmarshalBinary(data []byte) error
+
+	// String returns string representation of the filter.
+	String() string
+}
+
+type bloomFilter[T any] struct {
+	words  []uint64
+	hashes int
+}
+
+// NewBloom returns an empty BloomFilter sized to hold the expected number of
+// elements with the given false positive rate. It panics if expected is not
+// positive or if falsePositiveRate is not between 0 and 1.
+func NewBloom[T any](expected int, falsePositiveRate float64) BloomFilter[T] {
+	checkParameters(expected, falsePositiveRate)
+	m := optimalCells(expected, falsePositiveRate)
+	return &bloomFilter[T]{words: make([]uint64, m/64), hashes: optimalHashes(m, expected)}
+}
+
+func (f *bloomFilter[T]) Add(element T) {
+	locations(element, f.hashes, f.Bits(), func(cell int) {
+		f.words[cell/64] |= 1 << (cell % 64)
+	})
+}
+
+func (f *bloomFilter[T]) MightContain(element T) bool {
+	found := true
+	locations(element, f.hashes, f.Bits(), func(cell int) {
+		found = found && f.words[cell/64]&(1<<(cell%64)) != 0
+	})
+	return found
+}
+
+// ones returns the number of bits set.
+func (f *bloomFilter[T]) ones() int {
+	n := 0
+	for _, w := range f.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+func (f *bloomFilter[T]) ApproximateCount() int {
+	m := float64(f.Bits())
+	ones := float64(f.ones())
+	if ones == m {
+		// Every bit is set; the filter cannot tell how many elements it holds.
+		return math.MaxInt
+	}
+	return int(math.Round(-m / float64(f.hashes) * math.Log(1-ones/m)))
+}
+
+func (f *bloomFilter[T]) FalsePositiveRate() float64 {
+	return math.Pow(float64(f.ones())/float64(f.Bits()), float64(f.hashes))
+}
+
+func (f *bloomFilter[T]) Bits() int {
+	return 64 * len(f.words)
+}
+
+func (f *bloomFilter[T]) Hashes() int {
+	return f.hashes
+}
+
+// compatible returns other as a bloomFilter if it has the same parameters.
+func (f *bloomFilter[T]) compatible(other BloomFilter[T]) (*bloomFilter[T], error) {
+	o, ok := other.(*bloomFilter[T])
+	if !ok || len(o.words) != len(f.words) || o.hashes != f.hashes {
+		return nil, ErrIncompatible
+	}
+	return o, nil
+}
+
+func (f *bloomFilter[T]) Union(other BloomFilter[T]) error {
+	o, err := f.compatible(other)
+	if err != nil {
+		return err
+	}
+	for i, w := range o.words {
+		f.words[i] |= w
+	}
+	return nil
+}
+
+func (f *bloomFilter[T]) Intersect(other BloomFilter[T]) error {
+	o, err := f.compatible(other)
+	if err != nil {
+		return err
+	}
+	for i, w := range o.words {
+		f.words[i] &= w
+	}
+	return nil
+}
+
+func (f *bloomFilter[T]) Clear() {
+	clear(f.words)
+}
+
+func (f *bloomFilter[T]) MarshalBinary() ([]byte, error) {
+	data := header(kindBloom)
+	data = binary.BigEndian.AppendUint32(data, uint32(f.hashes))
+	data = binary.BigEndian.AppendUint64(data, uint64(len(f.words)))
+	for _, w := range f.words {
+		data = binary.BigEndian.AppendUint64(data, w)
+	}
+	return data, nil
+}
+
+func (f *bloomFilter[T]) UnmarshalBinary(data []byte) error {
+	d := newDecoder(data, kindBloom)
+	hashes := int(d.uint32())
+	words := make([]uint64, d.length(8))
+	for i := range words {
+		words[i] = d.uint64()
+	}
+	if err := d.finish(); err != nil {
+		return err
+	}
+	if hashes == 0 || len(words) == 0 {
+		return ErrInvalidEncoding
+	}
+	f.words = words
+	f.hashes = hashes
+	return nil
+}
+
+func (f *bloomFilter[T]) String() string {
+	return fmt.Sprintf("BloomFilter(bits: %d, hashes: %d, ones: %d)", f.Bits(), f.hashes, f.ones())
+}
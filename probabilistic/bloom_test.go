@@ -0,0 +1,165 @@
+package probabilistic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// falsePositives returns the fraction of n elements that were never added for
+// which mightContain returns true. Added elements are the integers from 0,
+// so the probes are negative.
+func falsePositives(n int, mightContain func(element int) bool) float64 {
+	positives := 0
+	for i := 1; i <= n; i++ {
+		if mightContain(-i) {
+			positives++
+		}
+	}
+	return float64(positives) / float64(n)
+}
+
+func TestBloomFilter_NoFalseNegatives(t *testing.T) {
+	f := NewBloom[string](1000, 0.01)
+	for i := range 1000 {
+		f.Add(fmt.Sprintf("user-%d", i))
+	}
+	for i := range 1000 {
+		if !f.MightContain(fmt.Sprintf("user-%d", i)) {
+			t.Fatalf("Expected filter to contain user-%d", i)
+		}
+	}
+}
+
+func TestBloomFilter_FalsePositiveRate(t *testing.T) {
+	for _, rate := range []float64{0.1, 0.01, 0.001} {
+		f := NewBloom[int](10000, rate)
+		for i := range 10000 {
+			f.Add(i)
+		}
+		if measured := falsePositives(100000, f.MightContain); measured > 1.5*rate {
+			t.Fatalf("Expected a false positive rate close to %v, but got %v", rate, measured)
+		}
+		if estimated := f.FalsePositiveRate(); math.Abs(estimated-rate) > rate/2 {
+			t.Fatalf("Expected an estimated false positive rate close to %v, but got %v", rate, estimated)
+		}
+		if count := f.ApproximateCount(); math.Abs(float64(count-10000)) > 300 {
+			t.Fatalf("Expected an approximate count close to 10000, but got %v", count)
+		}
+	}
+}
+
+func TestBloomFilter_Sizing(t *testing.T) {
+	f := NewBloom[int](1000, 0.01)
+	// 1000 elements at 1% take 9586 bits and 7 hashes.
+	if f.Bits() != 9600 || f.Hashes() != 7 {
+		t.Fatalf("Expected 9600 bits and 7 hashes, but got %v and %v", f.Bits(), f.Hashes())
+	}
+}
+
+func TestBloomFilter_InvalidParameters(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		expected int
+		rate     float64
+	}{
+		{name: "zero expected count", expected: 0, rate: 0.01},
+		{name: "zero rate", expected: 10, rate: 0},
+		{name: "rate of one", expected: 10, rate: 1},
+		{name: "NaN rate", expected: 10, rate: math.NaN()},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("Expected NewBloom to panic")
+				}
+			}()
+			NewBloom[int](s.expected, s.rate)
+		})
+	}
+}
+
+func TestBloomFilter_UnionIntersect(t *testing.T) {
+	evens, small := NewBloom[int](100, 0.001), NewBloom[int](100, 0.001)
+	for i := 0; i < 100; i += 2 {
+		evens.Add(i)
+	}
+	for i := range 50 {
+		small.Add(i)
+	}
+
+	union := NewBloom[int](100, 0.001)
+	_ = union.Union(evens)
+	if err := union.Union(small); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	for i := range 100 {
+		if expected := i%2 == 0 || i < 50; expected && !union.MightContain(i) {
+			t.Fatalf("Expected union to contain %v", i)
+		}
+	}
+
+	if err := evens.Intersect(small); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	for i := 0; i < 50; i += 2 {
+		if !evens.MightContain(i) {
+			t.Fatalf("Expected intersection to contain %v", i)
+		}
+	}
+	if evens.MightContain(98) || evens.MightContain(49) {
+		t.Fatalf("Expected intersection to drop elements of a single filter")
+	}
+
+	if err := evens.Union(NewBloom[int](1000, 0.001)); !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("Expected ErrIncompatible, but got %v", err)
+	}
+	if err := evens.Intersect(NewBloom[int](100, 0.1)); !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("Expected ErrIncompatible, but got %v", err)
+	}
+}
+
+func TestBloomFilter_Binary(t *testing.T) {
+	f := NewBloom[string](100, 0.01)
+	f.Add("alice")
+	f.Add("bob")
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	decoded := NewBloom[string](1, 0.5)
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if decoded.Bits() != f.Bits() || decoded.Hashes() != f.Hashes() {
+		t.Fatalf("Expected decoded filter to have the parameters of the original, but got %v", decoded)
+	}
+	if !decoded.MightContain("alice") || !decoded.MightContain("bob") {
+		t.Fatalf("Expected decoded filter to contain the original elements")
+	}
+	if err := decoded.Union(f); err != nil {
+		t.Fatalf("Expected decoded filter to be compatible with the original, but got %v", err)
+	}
+
+	for _, invalid := range [][]byte{nil, data[:len(data)-1], append(data, 0), {kindCountingBloom, encodingVersion}} {
+		if err := decoded.UnmarshalBinary(invalid); !errors.Is(err, ErrInvalidEncoding) {
+			t.Fatalf("Expected ErrInvalidEncoding, but got %v", err)
+		}
+	}
+	if !decoded.MightContain("alice") {
+		t.Fatalf("Expected a failed decoding to leave the filter unchanged")
+	}
+}
+
+func TestBloomFilter_Clear(t *testing.T) {
+	f := NewBloom[int](10, 0.01)
+	f.Add(1)
+	f.Clear()
+	if f.MightContain(1) || f.ApproximateCount() != 0 {
+		t.Fatalf("Expected filter to be empty after Clear, but got %v", f)
+	}
+}
@@ -0,0 +1,283 @@
+package probabilistic
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/elias8/go-gather/heap"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// HeavyHitter is an element tracked by a CountMinSketch together with its
+// estimated count.
+type HeavyHitter[T any] struct {
+	Element T
+	Count   uint64
+}
+
+// CountMinSketch estimates how many times each element was added to it, in a
+// fixed amount of memory. Estimates never undercount: with probability
+// 1 - delta, an estimate exceeds the true count by at most epsilon times the
+// total count of all elements.
+//
+// The sketch uses conservative update, which only raises the counters that
+// would otherwise underestimate the element, making estimates tighter. It can
+// also track the k elements with the highest estimated counts.
+type CountMinSketch[T comparable] interface {
+	// Add adds one occurrence of the element to the sketch.
+	Add(element T)
+
+	// AddCount adds count occurrences of the element to the sketch.
+	AddCount(element T, count uint64)
+
+	// Estimate returns the estimated number of occurrences of the element.
+	Estimate(element T) uint64
+
+	// Total returns the number of occurrences added to the sketch.
+	Total() uint64
+
+	// TopK returns the tracked elements with the highest estimated counts,
+	// from the highest to the lowest. It returns at most k elements, where k
+	// is the number given to NewCountMinSketch.
+	TopK() []HeavyHitter[T]
+
+	// Merge adds the occurrences of other to the sketch, and keeps tracking
+	// the elements with the highest estimated counts among those tracked by
+	// either. It fails with ErrIncompatible unless other has the same width,
+	// depth and k. Since other was not updated conservatively with respect to
+	// the sketch, merged estimates may be looser.
+	Merge(other CountMinSketch[T]) error
+
+	// Width returns the number of counters in each row of the sketch.
+	Width() int
+
+	// Depth returns the number of rows of the sketch.
+	Depth() int
+
+	// Clear removes all occurrences from the sketch.
+	Clear()
+
+	// MarshalBinary encodes the sketch so that UnmarshalBinary can restore
+	// it, possibly in another process. Tracked elements are encoded with gob.
+	MarshalBinary() ([]byte, error)
+
+	// UnmarshalBinary replaces the sketch with the decoded one, including its
+	// width, depth and k. It fails with ErrInvalidEncoding if data was not
+	// produced by MarshalBinary of a CountMinSketch.
+	UnmarshalBinary(data []byte) error
+
+	// String returns string representation of the sketch.
+	String() string
+}
+
+// countMinSketch keeps depth rows of width counters. An element maps to one
+// counter in every row, and its estimate is the smallest of them. The top k
+// elements are kept in a min-heap ordered by count, so that the element to
+// replace is at its root, with a handle per element to update its count.
+type countMinSketch[T comparable] struct {
+	counters []uint64
+	width    int
+	depth    int
+	total    uint64
+	k        int
+	top      heap.IndexedPriorityQueue[HeavyHitter[T]]
+	handles  map[T]*heap.Handle[HeavyHitter[T]]
+}
+
+// NewCountMinSketch returns an empty CountMinSketch whose estimates exceed
+// the true counts by at most epsilon times the total count, with probability
+// 1 - delta, and that tracks the k elements with the highest counts. It panics
+// if epsilon or delta is not between 0 and 1, or if k is negative.
+func NewCountMinSketch[T comparable](epsilon, delta float64, k int) CountMinSketch[T] {
+	if !(epsilon > 0 && epsilon < 1) || !(delta > 0 && delta < 1) {
+		panic("probabilistic: epsilon and delta must be between 0 and 1")
+	}
+	if k < 0 {
+		panic("probabilistic: negative k")
+	}
+	width := int(math.Ceil(math.E / epsilon))
+	depth := int(math.Ceil(math.Log(1 / delta)))
+	s := &countMinSketch[T]{counters: make([]uint64, width*depth), width: width, depth: depth, k: k}
+	s.resetTop()
+	return s
+}
+
+func (s *countMinSketch[T]) resetTop() {
+	s.top = heap.NewComparableIndexedFunc(func(a, b HeavyHitter[T]) int {
+		return cmp.Compare(a.Count, b.Count)
+	})
+	s.handles = make(map[T]*heap.Handle[HeavyHitter[T]])
+}
+
+// cells calls visit with the index of the counter the element maps to in
+// every row.
+func (s *countMinSketch[T]) cells(element T, visit func(cell int)) {
+	h1 := mix(hash(element))
+	h2 := mix(h1) | 1
+	for row := range s.depth {
+		visit(row*s.width + int((h1+uint64(row)*h2)%uint64(s.width)))
+	}
+}
+
+func (s *countMinSketch[T]) Add(element T) {
+	s.AddCount(element, 1)
+}
+
+func (s *countMinSketch[T]) AddCount(element T, count uint64) {
+	estimate := s.Estimate(element) + count
+	s.cells(element, func(cell int) {
+		s.counters[cell] = max(s.counters[cell], estimate)
+	})
+	s.total += count
+	s.track(element, estimate)
+}
+
+// track records the estimated count of the element among the top k if it is
+// already tracked or high enough.
+func (s *countMinSketch[T]) track(element T, estimate uint64) {
+	if s.k == 0 {
+		return
+	}
+	entry := HeavyHitter[T]{Element: element, Count: estimate}
+	if handle, ok := s.handles[element]; ok {
+		s.top.Update(handle, entry)
+		return
+	}
+	if s.top.Size() == s.k {
+		lowest, _ := s.top.Peek()
+		if lowest.Count >= estimate {
+			return
+		}
+		s.top.Poll()
+		delete(s.handles, lowest.Element)
+	}
+	s.handles[element] = s.top.Offer(entry)
+}
+
+func (s *countMinSketch[T]) Estimate(element T) uint64 {
+	estimate := uint64(math.MaxUint64)
+	s.cells(element, func(cell int) {
+		estimate = min(estimate, s.counters[cell])
+	})
+	return estimate
+}
+
+func (s *countMinSketch[T]) Total() uint64 {
+	return s.total
+}
+
+func (s *countMinSketch[T]) TopK() []HeavyHitter[T] {
+	top := s.top.Values()
+	slices.SortFunc(top, func(a, b HeavyHitter[T]) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+	return top
+}
+
+func (s *countMinSketch[T]) Merge(other CountMinSketch[T]) error {
+	o, ok := other.(*countMinSketch[T])
+	if !ok || o.width != s.width || o.depth != s.depth || o.k != s.k {
+		return ErrIncompatible
+	}
+	candidates := s.tracked()
+	if o != s {
+		candidates = append(candidates, o.tracked()...)
+	}
+	for i, c := range o.counters {
+		s.counters[i] += c
+	}
+	s.total += o.total
+	s.retrack(candidates)
+	return nil
+}
+
+// tracked returns the elements tracked among the top k.
+func (s *countMinSketch[T]) tracked() []T {
+	elements := make([]T, 0, len(s.handles))
+	for element := range s.handles {
+		elements = append(elements, element)
+	}
+	return elements
+}
+
+// retrack tracks the candidates with the highest current estimates among the
+// top k, forgetting the elements tracked so far.
+func (s *countMinSketch[T]) retrack(candidates []T) {
+	s.resetTop()
+	for _, element := range candidates {
+		if _, ok := s.handles[element]; !ok {
+			s.track(element, s.Estimate(element))
+		}
+	}
+}
+
+func (s *countMinSketch[T]) Width() int {
+	return s.width
+}
+
+func (s *countMinSketch[T]) Depth() int {
+	return s.depth
+}
+
+func (s *countMinSketch[T]) Clear() {
+	clear(s.counters)
+	s.total = 0
+	s.resetTop()
+}
+
+func (s *countMinSketch[T]) MarshalBinary() ([]byte, error) {
+	elements, err := encoding.MarshalBinary(s.tracked())
+	if err != nil {
+		return nil, err
+	}
+	data := header(kindCountMin)
+	data = binary.BigEndian.AppendUint32(data, uint32(s.depth))
+	data = binary.BigEndian.AppendUint32(data, uint32(s.k))
+	data = binary.BigEndian.AppendUint64(data, s.total)
+	data = binary.BigEndian.AppendUint64(data, uint64(len(s.counters)))
+	for _, c := range s.counters {
+		data = binary.BigEndian.AppendUint64(data, c)
+	}
+	return append(data, elements...), nil
+}
+
+func (s *countMinSketch[T]) UnmarshalBinary(data []byte) error {
+	d := newDecoder(data, kindCountMin)
+	depth := int(d.uint32())
+	k := int(d.uint32())
+	total := d.uint64()
+	counters := make([]uint64, d.length(8))
+	for i := range counters {
+		counters[i] = d.uint64()
+	}
+	// The tracked elements take the rest of the data.
+	rest := d.data
+	d.data = nil
+	if err := d.finish(); err != nil {
+		return err
+	}
+	if depth == 0 || len(counters) == 0 || len(counters)%depth != 0 {
+		return ErrInvalidEncoding
+	}
+	elements, err := encoding.UnmarshalBinary[T](rest)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+	if len(elements) > k {
+		return ErrInvalidEncoding
+	}
+	s.counters = counters
+	s.width = len(counters) / depth
+	s.depth = depth
+	s.total = total
+	s.k = k
+	s.retrack(elements)
+	return nil
+}
+
+func (s *countMinSketch[T]) String() string {
+	return fmt.Sprintf("CountMinSketch(width: %d, depth: %d, total: %d)", s.width, s.depth, s.total)
+}
@@ -0,0 +1,169 @@
+package probabilistic
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// zipf returns a stream of n keys drawn from a Zipf distribution over 10000
+// keys, where a few keys are much more frequent than the rest, and their
+// exact counts.
+func zipf(n int) ([]string, map[string]uint64) {
+	z := rand.NewZipf(rand.New(rand.NewSource(1)), 1.2, 1, 9999)
+	stream := make([]string, n)
+	exact := map[string]uint64{}
+	for i := range stream {
+		stream[i] = fmt.Sprintf("key-%d", z.Uint64())
+		exact[stream[i]]++
+	}
+	return stream, exact
+}
+
+func TestCountMinSketch_Accuracy(t *testing.T) {
+	const epsilon, delta = 0.001, 0.01
+	stream, exact := zipf(200000)
+	s := NewCountMinSketch[string](epsilon, delta, 0)
+	for _, key := range stream {
+		s.Add(key)
+	}
+
+	if s.Total() != uint64(len(stream)) {
+		t.Fatalf("Expected a total of %v, but got %v", len(stream), s.Total())
+	}
+	bound := uint64(epsilon * float64(len(stream)))
+	violations := 0
+	for key, count := range exact {
+		estimate := s.Estimate(key)
+		if estimate < count {
+			t.Fatalf("Expected the estimate of %v to never undercount %v, but got %v", key, count, estimate)
+		}
+		if estimate-count > bound {
+			violations++
+		}
+	}
+	if float64(violations) > delta*float64(len(exact)) {
+		t.Fatalf("Expected at most %v%% of the estimates to exceed the bound, but %v of %v did", 100*delta, violations, len(exact))
+	}
+	if estimate := s.Estimate("absent"); estimate > bound {
+		t.Fatalf("Expected the estimate of an absent key to be at most %v, but got %v", bound, estimate)
+	}
+}
+
+func TestCountMinSketch_ConservativeUpdate(t *testing.T) {
+	// A sketch this narrow collides a lot, which conservative update keeps
+	// from inflating the estimates as much as a plain update would.
+	stream, exact := zipf(50000)
+	s := NewCountMinSketch[string](0.05, 0.01, 0).(*countMinSketch[string])
+	plain := make([]uint64, len(s.counters))
+	for _, key := range stream {
+		s.Add(key)
+		s.cells(key, func(cell int) { plain[cell]++ })
+	}
+
+	var conservativeError, plainError uint64
+	for key, count := range exact {
+		plainEstimate := ^uint64(0)
+		s.cells(key, func(cell int) { plainEstimate = min(plainEstimate, plain[cell]) })
+		conservativeError += s.Estimate(key) - count
+		plainError += plainEstimate - count
+	}
+	if conservativeError >= plainError {
+		t.Fatalf("Expected conservative update to overcount less than %v, but got %v", plainError, conservativeError)
+	}
+}
+
+func TestCountMinSketch_TopK(t *testing.T) {
+	stream, exact := zipf(200000)
+	s := NewCountMinSketch[string](0.001, 0.01, 5)
+	for _, key := range stream {
+		s.Add(key)
+	}
+
+	keys := make([]string, 0, len(exact))
+	for key := range exact {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b string) int { return int(exact[b]) - int(exact[a]) })
+
+	top := s.TopK()
+	if len(top) != 5 {
+		t.Fatalf("Expected 5 heavy hitters, but got %v", top)
+	}
+	for i, hitter := range top {
+		if hitter.Element != keys[i] {
+			t.Fatalf("Expected heavy hitter %v to be %v, but got %v", i, keys[i], hitter.Element)
+		}
+		if hitter.Count != s.Estimate(hitter.Element) {
+			t.Fatalf("Expected the count of %v to be its estimate, but got %v", hitter.Element, hitter.Count)
+		}
+	}
+}
+
+func TestCountMinSketch_Merge(t *testing.T) {
+	stream, exact := zipf(100000)
+	first, second := NewCountMinSketch[string](0.001, 0.01, 3), NewCountMinSketch[string](0.001, 0.01, 3)
+	for i, key := range stream {
+		if i%2 == 0 {
+			first.Add(key)
+		} else {
+			second.Add(key)
+		}
+	}
+	if err := first.Merge(second); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	if first.Total() != uint64(len(stream)) {
+		t.Fatalf("Expected a total of %v, but got %v", len(stream), first.Total())
+	}
+	for key, count := range exact {
+		if first.Estimate(key) < count {
+			t.Fatalf("Expected the merged estimate of %v to never undercount %v", key, count)
+		}
+	}
+	if top := first.TopK(); len(top) != 3 || top[0].Element != "key-0" {
+		t.Fatalf("Expected key-0 to be the top heavy hitter, but got %v", top)
+	}
+
+	if err := first.Merge(NewCountMinSketch[string](0.01, 0.01, 3)); !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("Expected ErrIncompatible, but got %v", err)
+	}
+}
+
+func TestCountMinSketch_Binary(t *testing.T) {
+	s := NewCountMinSketch[string](0.01, 0.01, 2)
+	s.AddCount("a", 5)
+	s.AddCount("b", 3)
+	s.AddCount("c", 1)
+	data, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	decoded := NewCountMinSketch[string](0.5, 0.5, 0)
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if decoded.Width() != s.Width() || decoded.Depth() != s.Depth() || decoded.Total() != 9 || decoded.Estimate("a") != 5 {
+		t.Fatalf("Expected decoded sketch to match %v, but got %v", s, decoded)
+	}
+	if top := decoded.TopK(); !slices.Equal(top, []HeavyHitter[string]{{"a", 5}, {"b", 3}}) {
+		t.Fatalf("Expected decoded sketch to track [a b], but got %v", top)
+	}
+
+	if err := decoded.UnmarshalBinary(data[:20]); !errors.Is(err, ErrInvalidEncoding) {
+		t.Fatalf("Expected ErrInvalidEncoding, but got %v", err)
+	}
+}
+
+func TestCountMinSketch_Clear(t *testing.T) {
+	s := NewCountMinSketch[int](0.01, 0.01, 2)
+	s.Add(1)
+	s.Clear()
+	if s.Estimate(1) != 0 || s.Total() != 0 || len(s.TopK()) != 0 {
+		t.Fatalf("Expected sketch to be empty after Clear, but got %v", s)
+	}
+}
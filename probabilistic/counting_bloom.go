@@ -0,0 +1,174 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// CountingBloomFilter is a Bloom filter that keeps a counter instead of a bit
+// in every cell, so that elements can be removed. It uses eight times the
+// memory of a BloomFilter with the same false positive rate.
+//
+// Counters saturate at 255 and then never decrease, so a heavily loaded
+// filter keeps reporting the elements mapped to saturated cells. Removing an
+// element that was never added may make the filter miss elements that were.
+type CountingBloomFilter[T any] interface {
+	// Add adds the element to the filter.
+	Add(element T)
+
+	// Remove removes one occurrence of the element from the filter. Returns
+	// false, leaving the filter unchanged, if the element was definitely not
+	// added.
+	Remove(element T) bool
+
+	// MightContain returns false if the element was definitely not added to
+	// the filter, or was removed as many times as it was added, and true if
+	// it probably was.
+	MightContain(element T) bool
+
+	// Counters returns the number of counters of the filter.
+	Counters() int
+
+	// Hashes returns the number of counters each element increments.
+	Hashes() int
+
+	// Union adds the elements of other to the filter, summing the counters.
+	// It fails with ErrIncompatible unless other was created with the same
+	// number of counters and hashes.
+	Union(other CountingBloomFilter[T]) error
+
+	// Intersect keeps in the filter only the elements that other might
+	// contain too, keeping the smaller of each pair of counters. It fails with
+	// ErrIncompatible unless other was created with the same number of
+	// counters and hashes.
+	Intersect(other CountingBloomFilter[T]) error
+
+	// Clear removes all elements from the filter.
+	Clear()
+
+	// MarshalBinary encodes the filter so that UnmarshalBinary can restore
+	// it, possibly in another process.
+	MarshalBinary() ([]byte, error)
+
+	// UnmarshalBinary replaces the filter with the decoded one, including its
+	// number of counters and hashes. It fails with ErrInvalidEncoding if data
+	// was not produced by MarshalBinary of a counting Bloom filter.
+	UnmarshalBinary(data []byte) error
+
+	// String returns string representation of the filter.
+	String() string
+}
+
+type countingBloomFilter[T any] struct {
+	counters []uint8
+	hashes   int
+}
+
+// NewCountingBloom returns an empty CountingBloomFilter sized to hold the
+// expected number of elements with the given false positive rate. It panics
+// if expected is not positive or if falsePositiveRate is not between 0 and 1.
+func NewCountingBloom[T any](expected int, falsePositiveRate float64) CountingBloomFilter[T] {
+	checkParameters(expected, falsePositiveRate)
+	m := optimalCells(expected, falsePositiveRate)
+	return &countingBloomFilter[T]{counters: make([]uint8, m), hashes: optimalHashes(m, expected)}
+}
+
+func (f *countingBloomFilter[T]) Add(element T) {
+	locations(element, f.hashes, len(f.counters), func(cell int) {
+		if f.counters[cell] < math.MaxUint8 {
+			f.counters[cell]++
+		}
+	})
+}
+
+func (f *countingBloomFilter[T]) Remove(element T) bool {
+	if !f.MightContain(element) {
+		return false
+	}
+	locations(element, f.hashes, len(f.counters), func(cell int) {
+		if f.counters[cell] < math.MaxUint8 {
+			f.counters[cell]--
+		}
+	})
+	return true
+}
+
+func (f *countingBloomFilter[T]) MightContain(element T) bool {
+	found := true
+	locations(element, f.hashes, len(f.counters), func(cell int) {
+		found = found && f.counters[cell] > 0
+	})
+	return found
+}
+
+func (f *countingBloomFilter[T]) Counters() int {
+	return len(f.counters)
+}
+
+func (f *countingBloomFilter[T]) Hashes() int {
+	return f.hashes
+}
+
+// compatible returns other as a countingBloomFilter if it has the same
+// parameters.
+func (f *countingBloomFilter[T]) compatible(other CountingBloomFilter[T]) (*countingBloomFilter[T], error) {
+	o, ok := other.(*countingBloomFilter[T])
+	if !ok || len(o.counters) != len(f.counters) || o.hashes != f.hashes {
+		return nil, ErrIncompatible
+	}
+	return o, nil
+}
+
+func (f *countingBloomFilter[T]) Union(other CountingBloomFilter[T]) error {
+	o, err := f.compatible(other)
+	if err != nil {
+		return err
+	}
+	for i, c := range o.counters {
+		f.counters[i] = uint8(min(int(f.counters[i])+int(c), math.MaxUint8))
+	}
+	return nil
+}
+
+func (f *countingBloomFilter[T]) Intersect(other CountingBloomFilter[T]) error {
+	o, err := f.compatible(other)
+	if err != nil {
+		return err
+	}
+	for i, c := range o.counters {
+		f.counters[i] = min(f.counters[i], c)
+	}
+	return nil
+}
+
+func (f *countingBloomFilter[T]) Clear() {
+	clear(f.counters)
+}
+
+func (f *countingBloomFilter[T]) MarshalBinary() ([]byte, error) {
+	data := header(kindCountingBloom)
+	data = binary.BigEndian.AppendUint32(data, uint32(f.hashes))
+	data = binary.BigEndian.AppendUint64(data, uint64(len(f.counters)))
+	return append(data, f.counters...), nil
+}
+
+func (f *countingBloomFilter[T]) UnmarshalBinary(data []byte) error {
+	d := newDecoder(data, kindCountingBloom)
+	hashes := int(d.uint32())
+	counters := make([]uint8, d.length(1))
+	copy(counters, d.next(len(counters)))
+	if err := d.finish(); err != nil {
+		return err
+	}
+	if hashes == 0 || len(counters) == 0 {
+		return ErrInvalidEncoding
+	}
+	f.counters = counters
+	f.hashes = hashes
+	return nil
+}
+
+func (f *countingBloomFilter[T]) String() string {
+	return fmt.Sprintf("CountingBloomFilter(counters: %d, hashes: %d)", len(f.counters), f.hashes)
+}
@@ -0,0 +1,101 @@
+package probabilistic
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCountingBloomFilter_AddRemove(t *testing.T) {
+	f := NewCountingBloom[int](1000, 0.01)
+	for i := range 1000 {
+		f.Add(i)
+	}
+	f.Add(7)
+
+	for i := 0; i < 1000; i += 2 {
+		if !f.Remove(i) {
+			t.Fatalf("Expected Remove(%v) to succeed", i)
+		}
+	}
+	for i := 1; i < 1000; i += 2 {
+		if !f.MightContain(i) {
+			t.Fatalf("Expected filter to still contain %v", i)
+		}
+	}
+	if measured := falsePositives(1000, func(i int) bool { return f.MightContain(-2 * i) }); measured > 0.02 {
+		t.Fatalf("Expected removed elements to be gone, but %v of them remain", measured)
+	}
+
+	if !f.Remove(7) || !f.MightContain(7) {
+		t.Fatalf("Expected an element added twice to remain after one Remove")
+	}
+	if f.Remove(-1) {
+		t.Fatalf("Expected Remove of an element that was never added to fail")
+	}
+}
+
+func TestCountingBloomFilter_Saturation(t *testing.T) {
+	f := NewCountingBloom[int](10, 0.01)
+	for range 300 {
+		f.Add(1)
+	}
+	for range 300 {
+		f.Remove(1)
+	}
+	if !f.MightContain(1) {
+		t.Fatalf("Expected saturated counters to never decrease")
+	}
+}
+
+func TestCountingBloomFilter_UnionIntersect(t *testing.T) {
+	a, b := NewCountingBloom[string](100, 0.001), NewCountingBloom[string](100, 0.001)
+	a.Add("x")
+	a.Add("y")
+	b.Add("y")
+	b.Add("z")
+
+	union := NewCountingBloom[string](100, 0.001)
+	_ = union.Union(a)
+	if err := union.Union(b); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	union.Remove("y")
+	if !union.MightContain("x") || !union.MightContain("y") || !union.MightContain("z") {
+		t.Fatalf("Expected union to count y twice, but got %v", union)
+	}
+
+	if err := a.Intersect(b); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if a.MightContain("x") || !a.MightContain("y") || a.MightContain("z") {
+		t.Fatalf("Expected intersection to contain only y")
+	}
+
+	if err := a.Union(NewCountingBloom[string](10, 0.001)); !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("Expected ErrIncompatible, but got %v", err)
+	}
+}
+
+func TestCountingBloomFilter_Binary(t *testing.T) {
+	f := NewCountingBloom[string](100, 0.01)
+	f.Add("alice")
+	f.Add("alice")
+	data, _ := f.MarshalBinary()
+
+	decoded := NewCountingBloom[string](1, 0.5)
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if decoded.Counters() != f.Counters() || decoded.Hashes() != f.Hashes() {
+		t.Fatalf("Expected decoded filter to have the parameters of the original, but got %v", decoded)
+	}
+	decoded.Remove("alice")
+	if !decoded.MightContain("alice") {
+		t.Fatalf("Expected decoded filter to keep the counters of the original")
+	}
+
+	bloom, _ := NewBloom[string](100, 0.01).MarshalBinary()
+	if err := decoded.UnmarshalBinary(bloom); !errors.Is(err, ErrInvalidEncoding) {
+		t.Fatalf("Expected ErrInvalidEncoding, but got %v", err)
+	}
+}
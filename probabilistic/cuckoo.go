@@ -0,0 +1,358 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+)
+
+const (
+	// bucketSize is the number of fingerprints a bucket of a cuckoo filter
+	// holds, which lets the filter fill 95% of its slots.
+	bucketSize = 4
+
+	// maxKicks is the number of fingerprints an insertion relocates before it
+	// gives up and declares the filter full.
+	maxKicks = 500
+)
+
+// CuckooFilter is a set that may report elements it does not contain, but
+// never misses elements it does contain, like a Bloom filter. It stores a
+// short fingerprint of every element in one of two buckets, which lets it
+// remove elements, and count them, and makes lookups faster. Unlike a Bloom
+// filter, it has a fixed capacity: once it is full, Add fails.
+//
+// Adding an element several times stores several fingerprints, which then
+// take as many Remove calls to disappear. Removing an element that was never
+// added may remove the fingerprint of another element.
+type CuckooFilter[T any] interface {
+	// Add adds the element to the filter. Returns false, leaving the filter
+	// unchanged, if the filter is full.
+	Add(element T) bool
+
+	// Remove removes one occurrence of the element from the filter. Returns
+	// false if the element was definitely not added.
+	Remove(element T) bool
+
+	// MightContain returns false if the element was definitely not added to
+	// the filter, or was removed as many times as it was added, and true if
+	// it probably was.
+	MightContain(element T) bool
+
+	// Count returns the number of fingerprints stored in the filter, which is
+	// the number of elements added and not removed.
+	Count() int
+
+	// Cap returns the number of fingerprints the filter has room for. Add
+	// usually starts failing when the filter holds about 95% of Cap.
+	Cap() int
+
+	// IsFull returns true if the last Add could not find room for every
+	// fingerprint, after which Add fails until an element is removed.
+	IsFull() bool
+
+	// Union adds the elements of other to the filter. It fails with
+	// ErrIncompatible unless other was created with the same capacity and
+	// fingerprint size, and with ErrFull if the filter runs out of room, in
+	// which case only some of the elements of other were added.
+	Union(other CuckooFilter[T]) error
+
+	// Intersect removes from the filter the elements that other definitely
+	// does not contain. It fails with ErrIncompatible unless other was
+	// created with the same capacity and fingerprint size.
+	Intersect(other CuckooFilter[T]) error
+
+	// Clear removes all elements from the filter.
+	Clear()
+
+	// MarshalBinary encodes the filter so that UnmarshalBinary can restore
+	// it, possibly in another process.
+	MarshalBinary() ([]byte, error)
+
+	// UnmarshalBinary replaces the filter with the decoded one, including its
+	// capacity and fingerprint size. It fails with ErrInvalidEncoding if data
+	// was not produced by MarshalBinary of a cuckoo filter.
+	UnmarshalBinary(data []byte) error
+
+	// String returns string representation of the filter.
+	String() string
+}
+
+// cuckooFilter stores fingerprints in buckets of bucketSize slots, bucket i
+// taking slots[i*bucketSize:(i+1)*bucketSize]. A zero slot is empty, so no
+// fingerprint is zero. The fingerprint of an element lives in bucket i1,
+// derived from the hash of the element, or in bucket alternate(i1), derived
+// from i1 and the fingerprint alone, so that it can be moved without knowing
+// the element. When an insertion fails, the last fingerprint it displaced is
+// kept aside as the victim, so that no element is lost.
+type cuckooFilter[T any] struct {
+	slots           []uint32
+	mask            uint64
+	fingerprintBits int
+	count           int
+	victim          uint32
+	victimBucket    uint64
+}
+
+// NewCuckoo returns an empty CuckooFilter with room for the expected number of
+// elements, whose fingerprints are long enough for the given false positive
+// rate. It panics if expected is not positive or if falsePositiveRate is not
+// between 0 and 1.
+func NewCuckoo[T any](expected int, falsePositiveRate float64) CuckooFilter[T] {
+	checkParameters(expected, falsePositiveRate)
+	buckets := uint64(math.Ceil(float64(expected) / (bucketSize * 0.95)))
+	buckets = 1 << bits.Len64(buckets-1)
+	fingerprintBits := int(math.Ceil(math.Log2(2 * bucketSize / falsePositiveRate)))
+	return &cuckooFilter[T]{
+		slots:           make([]uint32, buckets*bucketSize),
+		mask:            buckets - 1,
+		fingerprintBits: min(max(fingerprintBits, 4), 32),
+	}
+}
+
+// locate returns the fingerprint of the element and its first bucket.
+func (f *cuckooFilter[T]) locate(element T) (uint32, uint64) {
+	h := hash(element)
+	fingerprint := uint32(h>>32) & uint32(1<<f.fingerprintBits-1)
+	if fingerprint == 0 {
+		fingerprint = 1
+	}
+	return fingerprint, h & f.mask
+}
+
+// alternate returns the other bucket a fingerprint stored in bucket i can
+// live in. It is its own inverse.
+func (f *cuckooFilter[T]) alternate(i uint64, fingerprint uint32) uint64 {
+	return (i ^ mix(uint64(fingerprint))) & f.mask
+}
+
+func (f *cuckooFilter[T]) bucket(i uint64) []uint32 {
+	return f.slots[i*bucketSize : (i+1)*bucketSize]
+}
+
+// store puts the fingerprint into an empty slot of bucket i, if there is one.
+func (f *cuckooFilter[T]) store(i uint64, fingerprint uint32) bool {
+	bucket := f.bucket(i)
+	for s, v := range bucket {
+		if v == 0 {
+			bucket[s] = fingerprint
+			return true
+		}
+	}
+	return false
+}
+
+// delete removes the fingerprint from bucket i, if it is there.
+func (f *cuckooFilter[T]) delete(i uint64, fingerprint uint32) bool {
+	bucket := f.bucket(i)
+	for s, v := range bucket {
+		if v == fingerprint {
+			bucket[s] = 0
+			return true
+		}
+	}
+	return false
+}
+
+// has reports whether a fingerprint that may live in bucket i is stored.
+func (f *cuckooFilter[T]) has(i uint64, fingerprint uint32) bool {
+	j := f.alternate(i, fingerprint)
+	for s := range bucketSize {
+		if f.slots[i*bucketSize+uint64(s)] == fingerprint || f.slots[j*bucketSize+uint64(s)] == fingerprint {
+			return true
+		}
+	}
+	return f.victim == fingerprint && (f.victimBucket == i || f.victimBucket == j)
+}
+
+// place stores a fingerprint that may live in bucket i, relocating other
+// fingerprints to their alternate bucket to make room if needed. If that
+// fails, the last displaced fingerprint becomes the victim. The filter must
+// not have a victim already.
+func (f *cuckooFilter[T]) place(i uint64, fingerprint uint32) {
+	if f.store(i, fingerprint) {
+		return
+	}
+	i = f.alternate(i, fingerprint)
+	if f.store(i, fingerprint) {
+		return
+	}
+	for range maxKicks {
+		s := i*bucketSize + rand.Uint64N(bucketSize)
+		fingerprint, f.slots[s] = f.slots[s], fingerprint
+		i = f.alternate(i, fingerprint)
+		if f.store(i, fingerprint) {
+			return
+		}
+	}
+	f.victim, f.victimBucket = fingerprint, i
+}
+
+func (f *cuckooFilter[T]) Add(element T) bool {
+	if f.victim != 0 {
+		return false
+	}
+	fingerprint, i := f.locate(element)
+	f.place(i, fingerprint)
+	f.count++
+	return true
+}
+
+func (f *cuckooFilter[T]) Remove(element T) bool {
+	fingerprint, i := f.locate(element)
+	return f.remove(i, fingerprint)
+}
+
+// remove removes a fingerprint that may live in bucket i, and then gives the
+// victim, if any, another chance to be stored.
+func (f *cuckooFilter[T]) remove(i uint64, fingerprint uint32) bool {
+	j := f.alternate(i, fingerprint)
+	switch {
+	case f.victim == fingerprint && (f.victimBucket == i || f.victimBucket == j):
+		f.victim = 0
+	case f.delete(i, fingerprint) || f.delete(j, fingerprint):
+		if f.victim != 0 {
+			victim := f.victim
+			f.victim = 0
+			f.place(f.victimBucket, victim)
+		}
+	default:
+		return false
+	}
+	f.count--
+	return true
+}
+
+func (f *cuckooFilter[T]) MightContain(element T) bool {
+	fingerprint, i := f.locate(element)
+	return f.has(i, fingerprint)
+}
+
+func (f *cuckooFilter[T]) Count() int {
+	return f.count
+}
+
+func (f *cuckooFilter[T]) Cap() int {
+	return len(f.slots)
+}
+
+func (f *cuckooFilter[T]) IsFull() bool {
+	return f.victim != 0
+}
+
+// compatible returns other as a cuckooFilter if it has the same parameters.
+func (f *cuckooFilter[T]) compatible(other CuckooFilter[T]) (*cuckooFilter[T], error) {
+	o, ok := other.(*cuckooFilter[T])
+	if !ok || len(o.slots) != len(f.slots) || o.fingerprintBits != f.fingerprintBits {
+		return nil, ErrIncompatible
+	}
+	return o, nil
+}
+
+// fingerprints calls visit with every fingerprint of the filter and a bucket
+// it may live in, including the victim.
+func (f *cuckooFilter[T]) fingerprints(visit func(i uint64, fingerprint uint32)) {
+	for s, fingerprint := range f.slots {
+		if fingerprint != 0 {
+			visit(uint64(s/bucketSize), fingerprint)
+		}
+	}
+	if f.victim != 0 {
+		visit(f.victimBucket, f.victim)
+	}
+}
+
+func (f *cuckooFilter[T]) Union(other CuckooFilter[T]) error {
+	o, err := f.compatible(other)
+	if err != nil {
+		return err
+	}
+	if o == f {
+		o = f.clone()
+	}
+	o.fingerprints(func(i uint64, fingerprint uint32) {
+		if err == nil && f.victim != 0 {
+			err = ErrFull
+		}
+		if err == nil {
+			f.place(i, fingerprint)
+			f.count++
+		}
+	})
+	return err
+}
+
+func (f *cuckooFilter[T]) Intersect(other CuckooFilter[T]) error {
+	o, err := f.compatible(other)
+	if err != nil {
+		return err
+	}
+	var missing []uint64
+	f.fingerprints(func(i uint64, fingerprint uint32) {
+		if !o.has(i, fingerprint) {
+			missing = append(missing, i, uint64(fingerprint))
+		}
+	})
+	for k := 0; k < len(missing); k += 2 {
+		f.remove(missing[k], uint32(missing[k+1]))
+	}
+	return nil
+}
+
+func (f *cuckooFilter[T]) clone() *cuckooFilter[T] {
+	c := *f
+	c.slots = append([]uint32(nil), f.slots...)
+	return &c
+}
+
+func (f *cuckooFilter[T]) Clear() {
+	clear(f.slots)
+	f.count = 0
+	f.victim = 0
+}
+
+func (f *cuckooFilter[T]) MarshalBinary() ([]byte, error) {
+	data := header(kindCuckoo)
+	data = append(data, uint8(f.fingerprintBits))
+	data = binary.BigEndian.AppendUint64(data, uint64(f.count))
+	data = binary.BigEndian.AppendUint32(data, f.victim)
+	data = binary.BigEndian.AppendUint64(data, f.victimBucket)
+	data = binary.BigEndian.AppendUint64(data, uint64(len(f.slots)))
+	for _, fingerprint := range f.slots {
+		data = binary.BigEndian.AppendUint32(data, fingerprint)
+	}
+	return data, nil
+}
+
+func (f *cuckooFilter[T]) UnmarshalBinary(data []byte) error {
+	d := newDecoder(data, kindCuckoo)
+	fingerprintBits := int(d.uint8())
+	count := int(d.uint64())
+	victim := d.uint32()
+	victimBucket := d.uint64()
+	slots := make([]uint32, d.length(4))
+	for i := range slots {
+		slots[i] = d.uint32()
+	}
+	if err := d.finish(); err != nil {
+		return err
+	}
+	buckets := uint64(len(slots) / bucketSize)
+	if fingerprintBits < 4 || fingerprintBits > 32 || len(slots)%bucketSize != 0 ||
+		bits.OnesCount64(buckets) != 1 || victimBucket >= buckets || count < 0 || count > len(slots)+1 {
+		return ErrInvalidEncoding
+	}
+	f.slots = slots
+	f.mask = buckets - 1
+	f.fingerprintBits = fingerprintBits
+	f.count = count
+	f.victim = victim
+	f.victimBucket = victimBucket
+	return nil
+}
+
+func (f *cuckooFilter[T]) String() string {
+	return fmt.Sprintf("CuckooFilter(count: %d, capacity: %d, fingerprint bits: %d)", f.count, len(f.slots), f.fingerprintBits)
+}
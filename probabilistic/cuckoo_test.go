@@ -0,0 +1,131 @@
+package probabilistic
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCuckooFilter_AddRemove(t *testing.T) {
+	f := NewCuckoo[int](1000, 0.01)
+	for i := range 1000 {
+		if !f.Add(i) {
+			t.Fatalf("Expected Add(%v) to succeed", i)
+		}
+	}
+	if f.Count() != 1000 {
+		t.Fatalf("Expected 1000 elements, but got %v", f.Count())
+	}
+
+	for i := 0; i < 1000; i += 2 {
+		if !f.Remove(i) {
+			t.Fatalf("Expected Remove(%v) to succeed", i)
+		}
+	}
+	for i := 1; i < 1000; i += 2 {
+		if !f.MightContain(i) {
+			t.Fatalf("Expected filter to still contain %v", i)
+		}
+	}
+	if f.Count() != 500 {
+		t.Fatalf("Expected 500 elements, but got %v", f.Count())
+	}
+}
+
+func TestCuckooFilter_FalsePositiveRate(t *testing.T) {
+	for _, rate := range []float64{0.01, 0.001} {
+		f := NewCuckoo[int](10000, rate)
+		for i := range 10000 {
+			f.Add(i)
+		}
+		if measured := falsePositives(100000, f.MightContain); measured > rate {
+			t.Fatalf("Expected a false positive rate below %v, but got %v", rate, measured)
+		}
+	}
+}
+
+func TestCuckooFilter_Full(t *testing.T) {
+	f := NewCuckoo[int](100, 0.01)
+	added := 0
+	for f.Add(added) {
+		added++
+	}
+	if !f.IsFull() || f.Count() != added {
+		t.Fatalf("Expected the filter to be full with %v elements, but got %v", added, f)
+	}
+	if load := float64(added) / float64(f.Cap()); load < 0.85 {
+		t.Fatalf("Expected the filter to fill most of its slots, but it filled %v", load)
+	}
+	for i := range added {
+		if !f.MightContain(i) {
+			t.Fatalf("Expected a full filter to still contain %v", i)
+		}
+	}
+
+	if !f.Remove(0) || f.IsFull() {
+		t.Fatalf("Expected Remove to make room in a full filter")
+	}
+	for i := 1; i < added; i++ {
+		if !f.MightContain(i) {
+			t.Fatalf("Expected filter to still contain %v", i)
+		}
+	}
+}
+
+func TestCuckooFilter_UnionIntersect(t *testing.T) {
+	a, b := NewCuckoo[string](100, 0.001), NewCuckoo[string](100, 0.001)
+	a.Add("x")
+	a.Add("y")
+	b.Add("y")
+	b.Add("z")
+
+	union := NewCuckoo[string](100, 0.001)
+	_ = union.Union(a)
+	if err := union.Union(b); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if union.Count() != 4 || !union.MightContain("x") || !union.MightContain("z") {
+		t.Fatalf("Expected union to hold the fingerprints of both filters, but got %v", union)
+	}
+
+	if err := a.Intersect(b); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if a.Count() != 1 || a.MightContain("x") || !a.MightContain("y") {
+		t.Fatalf("Expected intersection to contain only y, but got %v", a)
+	}
+
+	if err := a.Union(NewCuckoo[string](1000, 0.001)); !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("Expected ErrIncompatible, but got %v", err)
+	}
+
+	full := NewCuckoo[int](10, 0.01)
+	for i := 0; full.Add(i); i++ {
+	}
+	other := NewCuckoo[int](10, 0.01)
+	other.Add(-1)
+	if err := full.Union(other); !errors.Is(err, ErrFull) {
+		t.Fatalf("Expected ErrFull, but got %v", err)
+	}
+}
+
+func TestCuckooFilter_Binary(t *testing.T) {
+	f := NewCuckoo[string](100, 0.01)
+	f.Add("alice")
+	f.Add("bob")
+	data, _ := f.MarshalBinary()
+
+	decoded := NewCuckoo[string](1, 0.5)
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if decoded.Count() != 2 || decoded.Cap() != f.Cap() || !decoded.MightContain("alice") {
+		t.Fatalf("Expected decoded filter to match the original, but got %v", decoded)
+	}
+	if !decoded.Remove("bob") || decoded.MightContain("bob") {
+		t.Fatalf("Expected decoded filter to support Remove")
+	}
+
+	if err := decoded.UnmarshalBinary(data[:10]); !errors.Is(err, ErrInvalidEncoding) {
+		t.Fatalf("Expected ErrInvalidEncoding, but got %v", err)
+	}
+}
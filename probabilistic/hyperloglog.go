@@ -0,0 +1,259 @@
+package probabilistic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// HyperLogLog estimates the number of distinct elements added to it, using
+// 2^precision bytes at most however many elements are added. The standard
+// error of the estimate is 1.04/sqrt(2^precision), for example 0.81% with a
+// precision of 14, which takes 16 KiB.
+//
+// A HyperLogLog starts with a sparse representation that only stores the
+// registers that were set, and switches to a dense array of registers once
+// that stops saving memory, so that many small sketches stay small.
+type HyperLogLog[T any] interface {
+	// Add adds the element to the sketch.
+	Add(element T)
+
+	// Estimate returns the estimated number of distinct elements added to the
+	// sketch.
+	Estimate() uint64
+
+	// Merge adds the elements of other to the sketch, so that it estimates
+	// the number of distinct elements added to either. It fails with
+	// ErrIncompatible unless other has the same precision.
+	Merge(other HyperLogLog[T]) error
+
+	// Precision returns the base 2 logarithm of the number of registers.
+	Precision() int
+
+	// IsSparse returns true if the sketch still uses its sparse
+	// representation.
+	IsSparse() bool
+
+	// Clear removes all elements from the sketch, which becomes sparse again.
+	Clear()
+
+	// MarshalBinary encodes the sketch so that UnmarshalBinary can restore
+	// it, possibly in another process.
+	MarshalBinary() ([]byte, error)
+
+	// UnmarshalBinary replaces the sketch with the decoded one, including its
+	// precision. It fails with ErrInvalidEncoding if data was not produced by
+	// MarshalBinary of a HyperLogLog.
+	UnmarshalBinary(data []byte) error
+
+	// String returns string representation of the sketch.
+	String() string
+}
+
+const (
+	minPrecision = 4
+	maxPrecision = 18
+)
+
+// hyperLogLog keeps, for each of its registers, the highest rank seen among
+// the hashes that select the register, where the rank is the position of the
+// first one bit in the rest of the hash. Until registers is allocated, the
+// non-zero registers are kept in sparse instead.
+type hyperLogLog[T any] struct {
+	precision int
+	sparse    map[uint32]uint8
+	registers []uint8
+}
+
+// NewHyperLogLog returns an empty HyperLogLog with 2^precision registers. It
+// panics if precision is not between 4 and 18.
+func NewHyperLogLog[T any](precision int) HyperLogLog[T] {
+	if precision < minPrecision || precision > maxPrecision {
+		panic("probabilistic: precision must be between 4 and 18")
+	}
+	return &hyperLogLog[T]{precision: precision, sparse: make(map[uint32]uint8)}
+}
+
+func (h *hyperLogLog[T]) Add(element T) {
+	x := mix(hash(element))
+	register := uint32(x >> (64 - h.precision))
+	// The guard bit bounds the rank when the remaining bits are all zero.
+	rank := uint8(bits.LeadingZeros64(x<<h.precision|1<<(h.precision-1)) + 1)
+	h.set(register, rank)
+}
+
+// set raises the register to rank if it is lower.
+func (h *hyperLogLog[T]) set(register uint32, rank uint8) {
+	if h.registers != nil {
+		h.registers[register] = max(h.registers[register], rank)
+		return
+	}
+	if rank > h.sparse[register] {
+		h.sparse[register] = rank
+		h.densify()
+	}
+}
+
+// densify switches to the dense representation once the sparse one holds
+// more than an eighth of the registers, at which point a map takes more
+// memory than the array.
+func (h *hyperLogLog[T]) densify() {
+	if len(h.sparse) <= h.size()/8 {
+		return
+	}
+	h.registers = make([]uint8, h.size())
+	for register, rank := range h.sparse {
+		h.registers[register] = rank
+	}
+	h.sparse = nil
+}
+
+// size returns the number of registers.
+func (h *hyperLogLog[T]) size() int {
+	return 1 << h.precision
+}
+
+func (h *hyperLogLog[T]) Estimate() uint64 {
+	m := float64(h.size())
+	zeros := h.size()
+	sum := 0.0
+	visit := func(rank uint8) {
+		if rank > 0 {
+			zeros--
+			sum += math.Ldexp(1, -int(rank))
+		}
+	}
+	if h.registers != nil {
+		for _, rank := range h.registers {
+			visit(rank)
+		}
+	} else {
+		for _, rank := range h.sparse {
+			visit(rank)
+		}
+	}
+	sum += float64(zeros)
+
+	estimate := alpha(h.size()) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// Linear counting is more accurate while many registers are empty.
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(math.Round(estimate))
+}
+
+// alpha returns the constant correcting the bias of the raw estimate of a
+// HyperLogLog with m registers.
+func alpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+	return 0.7213 / (1 + 1.079/float64(m))
+}
+
+func (h *hyperLogLog[T]) Merge(other HyperLogLog[T]) error {
+	o, ok := other.(*hyperLogLog[T])
+	if !ok || o.precision != h.precision {
+		return ErrIncompatible
+	}
+	if o.registers != nil {
+		if h.registers == nil {
+			h.registers = make([]uint8, h.size())
+			for register, rank := range h.sparse {
+				h.registers[register] = rank
+			}
+			h.sparse = nil
+		}
+		for register, rank := range o.registers {
+			h.registers[register] = max(h.registers[register], rank)
+		}
+		return nil
+	}
+	for register, rank := range o.sparse {
+		h.set(register, rank)
+	}
+	return nil
+}
+
+func (h *hyperLogLog[T]) Precision() int {
+	return h.precision
+}
+
+func (h *hyperLogLog[T]) IsSparse() bool {
+	return h.registers == nil
+}
+
+func (h *hyperLogLog[T]) Clear() {
+	h.sparse = make(map[uint32]uint8)
+	h.registers = nil
+}
+
+// The encoding of a sparse sketch lists its non-zero registers, and that of a
+// dense sketch holds every register.
+const (
+	sparseEncoding byte = 0
+	denseEncoding  byte = 1
+)
+
+func (h *hyperLogLog[T]) MarshalBinary() ([]byte, error) {
+	data := append(header(kindHyperLogLog), uint8(h.precision))
+	if h.registers != nil {
+		return append(append(data, denseEncoding), h.registers...), nil
+	}
+	data = append(data, sparseEncoding)
+	data = binary.BigEndian.AppendUint64(data, uint64(len(h.sparse)))
+	for register, rank := range h.sparse {
+		data = binary.BigEndian.AppendUint32(data, register)
+		data = append(data, rank)
+	}
+	return data, nil
+}
+
+func (h *hyperLogLog[T]) UnmarshalBinary(data []byte) error {
+	d := newDecoder(data, kindHyperLogLog)
+	precision := int(d.uint8())
+	if precision < minPrecision || precision > maxPrecision {
+		d.fail()
+	}
+	decoded := &hyperLogLog[T]{precision: precision}
+	maxRank := uint8(64 - precision + 1)
+	switch d.uint8() {
+	case denseEncoding:
+		decoded.registers = append([]uint8(nil), d.next(decoded.size())...)
+		for _, rank := range decoded.registers {
+			if rank > maxRank {
+				d.fail()
+			}
+		}
+	case sparseEncoding:
+		decoded.sparse = make(map[uint32]uint8)
+		for range d.length(5) {
+			register, rank := d.uint32(), d.uint8()
+			if int(register) >= decoded.size() || rank == 0 || rank > maxRank {
+				d.fail()
+			}
+			decoded.sparse[register] = rank
+		}
+	default:
+		d.fail()
+	}
+	if err := d.finish(); err != nil {
+		return err
+	}
+	*h = *decoded
+	return nil
+}
+
+func (h *hyperLogLog[T]) String() string {
+	representation := "dense"
+	if h.registers == nil {
+		representation = "sparse"
+	}
+	return fmt.Sprintf("HyperLogLog(precision: %d, %s, estimate: %d)", h.precision, representation, h.Estimate())
+}
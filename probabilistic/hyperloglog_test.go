@@ -0,0 +1,133 @@
+package probabilistic
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// relativeError returns how far the estimate is from the exact count, as a
+// fraction of the exact count.
+func relativeError(estimate uint64, exact int) float64 {
+	return math.Abs(float64(estimate)-float64(exact)) / float64(exact)
+}
+
+func TestHyperLogLog_Accuracy(t *testing.T) {
+	for _, precision := range []int{10, 14} {
+		// Hashes are deterministic, so the estimates are too; three standard
+		// errors leave them a wide margin.
+		tolerance := 3 * 1.04 / math.Sqrt(float64(int(1)<<precision))
+		for _, distinct := range []int{10, 1000, 50000, 300000} {
+			t.Run(fmt.Sprintf("precision %d, %d distinct", precision, distinct), func(t *testing.T) {
+				h := NewHyperLogLog[string](precision)
+				for i := range distinct {
+					user := fmt.Sprintf("user-%d", i)
+					// Repeated elements must not change the estimate.
+					h.Add(user)
+					h.Add(user)
+				}
+				if e := relativeError(h.Estimate(), distinct); e > tolerance {
+					t.Fatalf("Expected an estimate within %.2f%% of %v, but got %v", 100*tolerance, distinct, h.Estimate())
+				}
+			})
+		}
+	}
+}
+
+func TestHyperLogLog_Sparse(t *testing.T) {
+	h := NewHyperLogLog[int](14)
+	if h.Estimate() != 0 || !h.IsSparse() {
+		t.Fatalf("Expected an empty sparse sketch, but got %v", h)
+	}
+	for i := range 100 {
+		h.Add(i)
+	}
+	if !h.IsSparse() || h.Estimate() != 100 {
+		t.Fatalf("Expected a sparse sketch estimating exactly 100, but got %v", h)
+	}
+	for i := range 10000 {
+		h.Add(i)
+	}
+	if h.IsSparse() {
+		t.Fatalf("Expected the sketch to become dense")
+	}
+	h.Clear()
+	if h.Estimate() != 0 || !h.IsSparse() {
+		t.Fatalf("Expected Clear to make the sketch empty and sparse, but got %v", h)
+	}
+}
+
+func TestHyperLogLog_Merge(t *testing.T) {
+	scenarios := []struct {
+		name         string
+		first, other int
+	}{
+		{name: "sparse into sparse", first: 100, other: 100},
+		{name: "dense into sparse", first: 100, other: 20000},
+		{name: "sparse into dense", first: 20000, other: 100},
+		{name: "dense into dense", first: 20000, other: 20000},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			// The sketches overlap on half of the elements of the smaller one.
+			h, other := NewHyperLogLog[int](14), NewHyperLogLog[int](14)
+			for i := range s.first {
+				h.Add(i)
+			}
+			offset := min(s.first, s.other) / 2
+			for i := range s.other {
+				other.Add(offset + i)
+			}
+			if err := h.Merge(other); err != nil {
+				t.Fatalf("Expected no error, but got %v", err)
+			}
+			exact := offset + s.other
+			if s.first > exact {
+				exact = s.first
+			}
+			if e := relativeError(h.Estimate(), exact); e > 0.03 {
+				t.Fatalf("Expected an estimate close to %v, but got %v", exact, h.Estimate())
+			}
+		})
+	}
+
+	if err := NewHyperLogLog[int](14).Merge(NewHyperLogLog[int](12)); !errors.Is(err, ErrIncompatible) {
+		t.Fatalf("Expected ErrIncompatible, but got %v", err)
+	}
+}
+
+func TestHyperLogLog_Binary(t *testing.T) {
+	for _, distinct := range []int{100, 20000} {
+		h := NewHyperLogLog[int](12)
+		for i := range distinct {
+			h.Add(i)
+		}
+		data, _ := h.MarshalBinary()
+
+		decoded := NewHyperLogLog[int](4)
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if decoded.Precision() != 12 || decoded.IsSparse() != h.IsSparse() || decoded.Estimate() != h.Estimate() {
+			t.Fatalf("Expected decoded sketch to match %v, but got %v", h, decoded)
+		}
+		if err := decoded.UnmarshalBinary(data[:len(data)-1]); !errors.Is(err, ErrInvalidEncoding) {
+			t.Fatalf("Expected ErrInvalidEncoding, but got %v", err)
+		}
+	}
+}
+
+func TestHyperLogLog_InvalidPrecision(t *testing.T) {
+	for _, precision := range []int{3, 19} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("Expected NewHyperLogLog(%v) to panic", precision)
+				}
+			}()
+			NewHyperLogLog[int](precision)
+		}()
+	}
+}
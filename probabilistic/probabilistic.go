@@ -0,0 +1,166 @@
+// Package probabilistic provides data structures that answer questions about
+// large sets of elements approximately, in a fraction of the memory an exact
+// answer needs.
+//
+// Elements are hashed with 64-bit FNV-1a: strings and byte slices through
+// their bytes, and other types through their value formatted with fmt's %v
+// verb. Hashes therefore do not depend on the process, so a structure encoded
+// with MarshalBinary by one service answers the same way when decoded by
+// another, as long as both format their elements identically.
+package probabilistic
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+var (
+	// ErrIncompatible is returned when combining structures that were not
+	// created with the same parameters.
+	ErrIncompatible = errors.New("probabilistic: incompatible parameters")
+
+	// ErrFull is returned when a cuckoo filter has no room left for the
+	// elements being added.
+	ErrFull = errors.New("probabilistic: filter is full")
+
+	// ErrInvalidEncoding is returned when decoding data that was not produced
+	// by MarshalBinary of the same kind of structure.
+	ErrInvalidEncoding = errors.New("probabilistic: invalid encoding")
+)
+
+// hash returns the 64-bit FNV-1a hash of the element.
+func hash[T any](element T) uint64 {
+	h := fnv.New64a()
+	switch v := any(element).(type) {
+	case string:
+		h.Write([]byte(v))
+	case []byte:
+		h.Write(v)
+	default:
+		fmt.Fprintf(h, "%v", v)
+	}
+	return h.Sum64()
+}
+
+// mix scrambles the bits of x with the finalizer of SplitMix64, to derive a
+// second hash from a first one.
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	return x ^ x>>31
+}
+
+// checkParameters panics if a structure cannot be sized for the expected
+// number of elements and the target false positive rate.
+func checkParameters(expected int, falsePositiveRate float64) {
+	if expected <= 0 {
+		panic("probabilistic: expected count must be positive")
+	}
+	if !(falsePositiveRate > 0 && falsePositiveRate < 1) {
+		panic("probabilistic: false positive rate must be between 0 and 1")
+	}
+}
+
+// optimalCells returns the number of cells of a Bloom filter that holds n
+// elements with a false positive rate of p, rounded up to a multiple of 64.
+func optimalCells(n int, p float64) int {
+	m := int(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	return (m + 63) / 64 * 64
+}
+
+// optimalHashes returns the number of hash functions that minimizes the false
+// positive rate of a Bloom filter of m cells holding n elements.
+func optimalHashes(m, n int) int {
+	return max(1, int(math.Round(float64(m)/float64(n)*math.Ln2)))
+}
+
+// locations calls visit with the k cells, out of m, that element maps to in a
+// Bloom filter, derived from two hashes by double hashing.
+func locations[T any](element T, k, m int, visit func(cell int)) {
+	h1 := hash(element)
+	h2 := mix(h1) | 1
+	for i := range k {
+		visit(int((h1 + uint64(i)*h2) % uint64(m)))
+	}
+}
+
+// The binary encodings start with a byte telling the kind of structure and a
+// byte telling the version of its encoding, followed by big-endian fields.
+const (
+	kindBloom         byte = 'B'
+	kindCountingBloom byte = 'C'
+	kindCuckoo        byte = 'K'
+	kindHyperLogLog   byte = 'H'
+	kindCountMin      byte = 'M'
+	encodingVersion   byte = 1
+)
+
+func header(kind byte) []byte {
+	return []byte{kind, encodingVersion}
+}
+
+// decoder reads the fields of a binary encoding. Once a read fails, every
+// later read returns zero and err holds ErrInvalidEncoding.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func newDecoder(data []byte, kind byte) *decoder {
+	d := &decoder{data: data}
+	if d.uint8() != kind || d.uint8() != encodingVersion {
+		d.fail()
+	}
+	return d
+}
+
+func (d *decoder) fail() {
+	d.data = nil
+	d.err = ErrInvalidEncoding
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil || len(d.data) < n {
+		d.fail()
+		return make([]byte, n)
+	}
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *decoder) uint8() uint8 {
+	return d.next(1)[0]
+}
+
+func (d *decoder) uint32() uint32 {
+	return binary.BigEndian.Uint32(d.next(4))
+}
+
+func (d *decoder) uint64() uint64 {
+	return binary.BigEndian.Uint64(d.next(8))
+}
+
+// length reads a count of items that each take size bytes, and fails, returning
+// zero, unless that many bytes remain.
+func (d *decoder) length(size int) int {
+	n := d.uint64()
+	if d.err != nil || n > uint64(len(d.data)/size) {
+		d.fail()
+		return 0
+	}
+	return int(n)
+}
+
+// finish returns the error of the decoder, failing if data is left over.
+func (d *decoder) finish() error {
+	if d.err == nil && len(d.data) > 0 {
+		d.fail()
+	}
+	return d.err
+}
@@ -0,0 +1,263 @@
+package queue
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+const minArrayDequeCapacity = 8
+
+// arrayDeque is a Deque backed by a growable ring buffer. The elements are
+// stored in buffer starting at head and wrapping around its end.
+type arrayDeque[T any] struct {
+	buffer []T
+	head   int
+	size   int
+	equal  func(a, b T) bool
+}
+
+// NewArrayDeque returns an empty Deque backed by a growable ring buffer.
+// Elements are compared using reflect.DeepEqual.
+func NewArrayDeque[T any]() Deque[T] {
+	return NewArrayDequeFunc(base.DeepEqual[T])
+}
+
+// NewArrayDequeFunc returns an empty Deque backed by a growable ring buffer
+// that compares elements using the given equal function. If equal is nil,
+// reflect.DeepEqual is used.
+func NewArrayDequeFunc[T any](equal func(a, b T) bool) Deque[T] {
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	return &arrayDeque[T]{equal: equal}
+}
+
+// NewComparableArrayDeque returns an empty Deque backed by a growable ring
+// buffer that compares elements using the == operator.
+func NewComparableArrayDeque[T comparable]() Deque[T] {
+	return NewArrayDequeFunc(base.Equal[T])
+}
+
+func (d *arrayDeque[T]) Size() int {
+	return d.size
+}
+
+func (d *arrayDeque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+func (d *arrayDeque[T]) Contains(element T) bool {
+	for i := 0; i < d.size; i++ {
+		if d.equal(d.buffer[d.index(i)], element) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *arrayDeque[T]) Values() []T {
+	var values []T
+	for i := 0; i < d.size; i++ {
+		values = append(values, d.buffer[d.index(i)])
+	}
+	return values
+}
+
+func (d *arrayDeque[T]) Clear() {
+	clear(d.buffer)
+	d.head = 0
+	d.size = 0
+}
+
+func (d *arrayDeque[T]) String() string {
+	str := "ArrayDeque(["
+	for i := 0; i < d.size; i++ {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", d.buffer[d.index(i)])
+	}
+	return str + "])"
+}
+
+func (d *arrayDeque[T]) Iterator() base.Iterator[T] {
+	return &arrayDequeIterator[T]{deque: d, last: -1}
+}
+
+func (d *arrayDeque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(d.buffer[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+func (d *arrayDeque[T]) Offer(element T) {
+	d.OfferLast(element)
+}
+
+func (d *arrayDeque[T]) Poll() (*T, bool) {
+	return d.PollFirst()
+}
+
+func (d *arrayDeque[T]) Peek() (*T, bool) {
+	return d.PeekFirst()
+}
+
+func (d *arrayDeque[T]) OfferFirst(element T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.buffer)) % len(d.buffer)
+	d.buffer[d.head] = element
+	d.size++
+}
+
+func (d *arrayDeque[T]) OfferLast(element T) {
+	d.grow()
+	d.buffer[d.index(d.size)] = element
+	d.size++
+}
+
+func (d *arrayDeque[T]) PollFirst() (*T, bool) {
+	if d.size == 0 {
+		return nil, false
+	}
+	removed := d.buffer[d.head]
+	var zero T
+	d.buffer[d.head] = zero
+	d.head = (d.head + 1) % len(d.buffer)
+	d.size--
+	return &removed, true
+}
+
+func (d *arrayDeque[T]) PollLast() (*T, bool) {
+	if d.size == 0 {
+		return nil, false
+	}
+	last := d.index(d.size - 1)
+	removed := d.buffer[last]
+	var zero T
+	d.buffer[last] = zero
+	d.size--
+	return &removed, true
+}
+
+func (d *arrayDeque[T]) PeekFirst() (*T, bool) {
+	if d.size == 0 {
+		return nil, false
+	}
+	return &d.buffer[d.head], true
+}
+
+func (d *arrayDeque[T]) PeekLast() (*T, bool) {
+	if d.size == 0 {
+		return nil, false
+	}
+	return &d.buffer[d.index(d.size-1)], true
+}
+
+// index maps the position of an element in the deque to its index in the
+// buffer.
+func (d *arrayDeque[T]) index(position int) int {
+	return (d.head + position) % len(d.buffer)
+}
+
+// grow doubles the capacity of the buffer if it is full, unwrapping the
+// elements so that the head is at index 0.
+func (d *arrayDeque[T]) grow() {
+	if d.size < len(d.buffer) {
+		return
+	}
+	buffer := make([]T, max(minArrayDequeCapacity, 2*len(d.buffer)))
+	for i := 0; i < d.size; i++ {
+		buffer[i] = d.buffer[d.index(i)]
+	}
+	d.buffer = buffer
+	d.head = 0
+}
+
+// removeAt removes the element at the given position by shifting the elements
+// after it one step towards the head.
+func (d *arrayDeque[T]) removeAt(position int) {
+	for i := position; i < d.size-1; i++ {
+		d.buffer[d.index(i)] = d.buffer[d.index(i+1)]
+	}
+	var zero T
+	d.buffer[d.index(d.size-1)] = zero
+	d.size--
+}
+
+type arrayDequeIterator[T any] struct {
+	deque  *arrayDeque[T]
+	cursor int
+	last   int
+}
+
+func (it *arrayDequeIterator[T]) HasNext() bool {
+	return it.cursor < it.deque.size
+}
+
+func (it *arrayDequeIterator[T]) Next() (*T, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	it.last = it.cursor
+	it.cursor++
+	return &it.deque.buffer[it.deque.index(it.last)], true
+}
+
+func (it *arrayDequeIterator[T]) Remove() bool {
+	if it.last < 0 {
+		return false
+	}
+	it.deque.removeAt(it.last)
+	it.cursor = it.last
+	it.last = -1
+	return true
+}
+
+func (d *arrayDeque[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(d.Values())
+}
+
+func (d *arrayDeque[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	d.replace(values)
+	return nil
+}
+
+func (d *arrayDeque[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(d.Values())
+}
+
+func (d *arrayDeque[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	d.replace(values)
+	return nil
+}
+
+func (d *arrayDeque[T]) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+func (d *arrayDeque[T]) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the deque with the decoded values.
+func (d *arrayDeque[T]) replace(values []T) {
+	d.Clear()
+	for _, v := range values {
+		d.OfferLast(v)
+	}
+}
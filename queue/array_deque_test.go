@@ -0,0 +1,151 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"slices"
+	"testing"
+)
+
+func TestNewArrayDeque(t *testing.T) {
+	deque := NewArrayDeque[int]()
+
+	if deque == nil {
+		t.Fatalf("Expected deque to not be nil")
+	}
+	if !deque.IsEmpty() {
+		t.Fatalf("Expected deque to be empty")
+	}
+	if _, found := deque.PollFirst(); found {
+		t.Fatalf("Expected deque.PollFirst() to not find an element")
+	}
+	if _, found := deque.PollLast(); found {
+		t.Fatalf("Expected deque.PollLast() to not find an element")
+	}
+	if _, found := deque.PeekFirst(); found {
+		t.Fatalf("Expected deque.PeekFirst() to not find an element")
+	}
+	if _, found := deque.PeekLast(); found {
+		t.Fatalf("Expected deque.PeekLast() to not find an element")
+	}
+}
+
+func TestArrayDeque_Grow(t *testing.T) {
+	deque := NewComparableArrayDeque[int]()
+	var expected []int
+	for i := 0; i < 3*minArrayDequeCapacity; i++ {
+		if i%2 == 0 {
+			deque.OfferLast(i)
+			expected = append(expected, i)
+		} else {
+			deque.OfferFirst(i)
+			expected = append([]int{i}, expected...)
+		}
+	}
+
+	if deque.Size() != len(expected) {
+		t.Fatalf("Expected deque to have %v elements, but got %v", len(expected), deque.Size())
+	}
+	if slice := deque.Values(); !slices.Equal(slice, expected) {
+		t.Fatalf("Expected deque to be %v, but got %v", expected, slice)
+	}
+}
+
+func TestArrayDeque_WrapAround(t *testing.T) {
+	deque := NewArrayDeque[int]()
+	for i := 0; i < minArrayDequeCapacity; i++ {
+		deque.Offer(i)
+	}
+	for i := 0; i < minArrayDequeCapacity/2; i++ {
+		deque.Poll()
+	}
+	for i := minArrayDequeCapacity; i < minArrayDequeCapacity+minArrayDequeCapacity/2; i++ {
+		deque.Offer(i)
+	}
+
+	expected := []int{4, 5, 6, 7, 8, 9, 10, 11}
+	if slice := slices.Collect(deque.All()); !slices.Equal(slice, expected) {
+		t.Fatalf("Expected deque to be %v, but got %v", expected, slice)
+	}
+	if val, found := deque.PeekLast(); !found || *val != 11 {
+		t.Fatalf("Expected deque.PeekLast() to return 11")
+	}
+	if val, found := deque.PollLast(); !found || *val != 11 {
+		t.Fatalf("Expected deque.PollLast() to return 11")
+	}
+	if !deque.Contains(10) || deque.Contains(11) {
+		t.Fatalf("Expected deque to contain 10 and not 11")
+	}
+}
+
+func TestArrayDeque_Iterator(t *testing.T) {
+	deque := NewArrayDeque[int]()
+	for i := 0; i < minArrayDequeCapacity; i++ {
+		deque.Offer(i)
+	}
+	deque.Poll()
+	deque.Poll()
+	deque.Offer(8)
+
+	it := deque.Iterator()
+	if it.Remove() {
+		t.Fatalf("Expected Remove to fail before calling Next")
+	}
+	for it.HasNext() {
+		if val, _ := it.Next(); *val%2 == 0 && !it.Remove() {
+			t.Fatalf("Expected Remove to remove %v", *val)
+		}
+	}
+
+	expected := []int{3, 5, 7}
+	if slice := deque.Values(); !slices.Equal(slice, expected) {
+		t.Fatalf("Expected deque to be %v, but got %v", expected, slice)
+	}
+}
+
+func TestArrayDeque_Clear(t *testing.T) {
+	deque := NewArrayDequeFunc[int](nil)
+	deque.Offer(1)
+	deque.Offer(2)
+	deque.Clear()
+
+	if !deque.IsEmpty() {
+		t.Fatalf("Expected deque to be empty after clearing")
+	}
+	deque.Offer(3)
+	if val, found := deque.Peek(); !found || *val != 3 {
+		t.Fatalf("Expected deque to be usable after clearing")
+	}
+}
+
+func TestArrayDeque_String(t *testing.T) {
+	deque := NewArrayDeque[int]()
+	deque.Offer(2)
+	deque.OfferFirst(1)
+
+	if deque.String() != "ArrayDeque([1, 2])" {
+		t.Fatalf("Expected deque.String() to return 'ArrayDeque([1, 2])', but got %v", deque.String())
+	}
+}
+
+func TestArrayDeque_Gob(t *testing.T) {
+	deque := NewArrayDeque[int]()
+	for i := range 10 {
+		deque.OfferFirst(i)
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(deque); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	decoded := NewArrayDeque[int]()
+	decoded.OfferLast(42)
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	expected := []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}
+	if slice := decoded.Values(); !slices.Equal(slice, expected) {
+		t.Fatalf("Expected decoded deque to be %v, but got %v", expected, slice)
+	}
+}
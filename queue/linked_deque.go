@@ -0,0 +1,167 @@
+package queue
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+	"github.com/elias8/go-gather/list"
+)
+
+type linkedDeque[T any] struct {
+	name       string
+	linkedList list.LinkedList[T]
+}
+
+// New returns an empty Queue backed by a LinkedList. Elements are compared
+// using reflect.DeepEqual.
+func New[T any]() Queue[T] {
+	return &linkedDeque[T]{name: "Queue", linkedList: list.NewLinkedList[T]()}
+}
+
+// NewFunc returns an empty Queue backed by a LinkedList that compares elements
+// using the given equal function. If equal is nil, reflect.DeepEqual is used.
+func NewFunc[T any](equal func(a, b T) bool) Queue[T] {
+	return &linkedDeque[T]{name: "Queue", linkedList: list.NewLinkedListFunc(equal, nil)}
+}
+
+// NewComparable returns an empty Queue backed by a LinkedList that compares
+// elements using the == operator.
+func NewComparable[T comparable]() Queue[T] {
+	return &linkedDeque[T]{name: "Queue", linkedList: list.NewComparableLinkedList[T]()}
+}
+
+// NewDeque returns an empty Deque backed by a LinkedList. Elements are
+// compared using reflect.DeepEqual.
+func NewDeque[T any]() Deque[T] {
+	return &linkedDeque[T]{name: "Deque", linkedList: list.NewLinkedList[T]()}
+}
+
+// NewDequeFunc returns an empty Deque backed by a LinkedList that compares
+// elements using the given equal function. If equal is nil,
+// reflect.DeepEqual is used.
+func NewDequeFunc[T any](equal func(a, b T) bool) Deque[T] {
+	return &linkedDeque[T]{name: "Deque", linkedList: list.NewLinkedListFunc(equal, nil)}
+}
+
+// NewComparableDeque returns an empty Deque backed by a LinkedList that
+// compares elements using the == operator.
+func NewComparableDeque[T comparable]() Deque[T] {
+	return &linkedDeque[T]{name: "Deque", linkedList: list.NewComparableLinkedList[T]()}
+}
+
+func (d *linkedDeque[T]) Size() int {
+	return d.linkedList.Size()
+}
+
+func (d *linkedDeque[T]) IsEmpty() bool {
+	return d.linkedList.IsEmpty()
+}
+
+func (d *linkedDeque[T]) Contains(element T) bool {
+	return d.linkedList.Contains(element)
+}
+
+func (d *linkedDeque[T]) Values() []T {
+	return d.linkedList.Values()
+}
+
+func (d *linkedDeque[T]) Clear() {
+	d.linkedList.Clear()
+}
+
+func (d *linkedDeque[T]) String() string {
+	str := d.name + "(["
+	for i, v := range d.linkedList.Values() {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", v)
+	}
+	return str + "])"
+}
+
+func (d *linkedDeque[T]) Iterator() base.Iterator[T] {
+	return d.linkedList.Iterator()
+}
+
+func (d *linkedDeque[T]) All() iter.Seq[T] {
+	return d.linkedList.All()
+}
+
+func (d *linkedDeque[T]) Offer(element T) {
+	d.linkedList.AddLast(element)
+}
+
+func (d *linkedDeque[T]) Poll() (*T, bool) {
+	return d.linkedList.RemoveFirst()
+}
+
+func (d *linkedDeque[T]) Peek() (*T, bool) {
+	return d.linkedList.GetFirst()
+}
+
+func (d *linkedDeque[T]) OfferFirst(element T) {
+	d.linkedList.AddFirst(element)
+}
+
+func (d *linkedDeque[T]) OfferLast(element T) {
+	d.linkedList.AddLast(element)
+}
+
+func (d *linkedDeque[T]) PollFirst() (*T, bool) {
+	return d.linkedList.RemoveFirst()
+}
+
+func (d *linkedDeque[T]) PollLast() (*T, bool) {
+	return d.linkedList.RemoveLast()
+}
+
+func (d *linkedDeque[T]) PeekFirst() (*T, bool) {
+	return d.linkedList.GetFirst()
+}
+
+func (d *linkedDeque[T]) PeekLast() (*T, bool) {
+	return d.linkedList.GetLast()
+}
+
+func (d *linkedDeque[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(d.Values())
+}
+
+func (d *linkedDeque[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	d.replace(values)
+	return nil
+}
+
+func (d *linkedDeque[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(d.Values())
+}
+
+func (d *linkedDeque[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	d.replace(values)
+	return nil
+}
+
+func (d *linkedDeque[T]) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+func (d *linkedDeque[T]) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the deque with the decoded values.
+func (d *linkedDeque[T]) replace(values []T) {
+	d.linkedList.Clear()
+	d.linkedList.AddAll(values...)
+}
@@ -0,0 +1,155 @@
+package queue
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	queue := New[int]()
+
+	if queue == nil {
+		t.Fatalf("Expected queue to not be nil")
+	}
+	if !queue.IsEmpty() {
+		t.Fatalf("Expected queue to be empty")
+	}
+}
+
+func TestNewFunc(t *testing.T) {
+	queue := NewFunc(func(a, b string) bool { return len(a) == len(b) })
+	queue.Offer("abc")
+
+	if !queue.Contains("xyz") {
+		t.Fatalf("Expected queue to contain a string of length 3")
+	}
+	if queue.Contains("ab") {
+		t.Fatalf("Expected queue to not contain a string of length 2")
+	}
+}
+
+func TestNewComparable(t *testing.T) {
+	queue := NewComparable[int]()
+	queue.Offer(1)
+
+	if !queue.Contains(1) {
+		t.Fatalf("Expected queue to contain 1")
+	}
+}
+
+func TestQueue_OfferPollPeek(t *testing.T) {
+	queue := New[int]()
+
+	if _, found := queue.Poll(); found {
+		t.Fatalf("Expected queue.Poll() to not find an element")
+	}
+	if _, found := queue.Peek(); found {
+		t.Fatalf("Expected queue.Peek() to not find an element")
+	}
+
+	queue.Offer(1)
+	queue.Offer(2)
+	queue.Offer(3)
+
+	if val, found := queue.Peek(); !found || *val != 1 {
+		t.Fatalf("Expected queue.Peek() to return 1")
+	}
+	for _, expected := range []int{1, 2, 3} {
+		val, found := queue.Poll()
+		if !found || *val != expected {
+			t.Fatalf("Expected queue.Poll() to return %v", expected)
+		}
+	}
+	if !queue.IsEmpty() {
+		t.Fatalf("Expected queue to be empty after polling every element")
+	}
+}
+
+func TestQueue_String(t *testing.T) {
+	queue := New[int]()
+	queue.Offer(1)
+	queue.Offer(2)
+
+	if queue.String() != "Queue([1, 2])" {
+		t.Fatalf("Expected queue.String() to return 'Queue([1, 2])', but got %v", queue.String())
+	}
+}
+
+func TestDeque_Ends(t *testing.T) {
+	deque := NewDeque[int]()
+	deque.OfferLast(2)
+	deque.OfferFirst(1)
+	deque.OfferLast(3)
+
+	expected := []int{1, 2, 3}
+	if slice := deque.Values(); !slices.Equal(slice, expected) {
+		t.Fatalf("Expected deque to be %v, but got %v", expected, slice)
+	}
+	if val, found := deque.PeekFirst(); !found || *val != 1 {
+		t.Fatalf("Expected deque.PeekFirst() to return 1")
+	}
+	if val, found := deque.PeekLast(); !found || *val != 3 {
+		t.Fatalf("Expected deque.PeekLast() to return 3")
+	}
+	if val, found := deque.PollLast(); !found || *val != 3 {
+		t.Fatalf("Expected deque.PollLast() to return 3")
+	}
+	if val, found := deque.PollFirst(); !found || *val != 1 {
+		t.Fatalf("Expected deque.PollFirst() to return 1")
+	}
+	if deque.String() != "Deque([2])" {
+		t.Fatalf("Expected deque.String() to return 'Deque([2])', but got %v", deque.String())
+	}
+}
+
+func TestDeque_Iterator(t *testing.T) {
+	deque := NewComparableDeque[int]()
+	for i := 1; i <= 4; i++ {
+		deque.Offer(i)
+	}
+
+	it := deque.Iterator()
+	for it.HasNext() {
+		if val, _ := it.Next(); *val%2 == 0 {
+			it.Remove()
+		}
+	}
+
+	expected := []int{1, 3}
+	if slice := slices.Collect(deque.All()); !slices.Equal(slice, expected) {
+		t.Fatalf("Expected deque to be %v, but got %v", expected, slice)
+	}
+}
+
+func TestDeque_Clear(t *testing.T) {
+	deque := NewDequeFunc[int](nil)
+	deque.Offer(1)
+	deque.Clear()
+
+	if !deque.IsEmpty() || deque.Size() != 0 {
+		t.Fatalf("Expected deque to be empty after clearing")
+	}
+}
+
+func TestQueue_JSON(t *testing.T) {
+	queue := New[int]()
+	queue.Offer(1)
+	queue.Offer(2)
+
+	data, err := json.Marshal(queue)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if string(data) != "[1,2]" {
+		t.Fatalf("Expected queue to be encoded head-first as [1,2], but got %s", data)
+	}
+
+	decoded := New[int]()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if val, _ := decoded.Poll(); *val != 1 {
+		t.Fatalf("Expected decoded queue to poll 1 first, but got %v", *val)
+	}
+}
@@ -0,0 +1,48 @@
+package queue
+
+import "github.com/elias8/go-gather/base"
+
+// Queue is a FIFO (first in, first out) data structure.
+type Queue[T any] interface {
+	base.Collection[T]
+
+	// Offer adds an element to the tail of the queue.
+	Offer(element T)
+
+	// Poll removes and returns the head of the queue. Returns nil and false if
+	// the queue is empty.
+	Poll() (*T, bool)
+
+	// Peek returns the head of the queue without removing it. Returns nil and
+	// false if the queue is empty.
+	Peek() (*T, bool)
+}
+
+// Deque is a double-ended queue that supports adding and removing elements at
+// both ends. Offer, Poll and Peek inherited from Queue are equivalent to
+// OfferLast, PollFirst and PeekFirst respectively.
+type Deque[T any] interface {
+	Queue[T]
+
+	// OfferFirst adds an element to the head of the deque.
+	OfferFirst(element T)
+
+	// OfferLast adds an element to the tail of the deque.
+	OfferLast(element T)
+
+	// PollFirst removes and returns the head of the deque. Returns nil and
+	// false if the deque is empty.
+	PollFirst() (*T, bool)
+
+	// PollLast removes and returns the tail of the deque. Returns nil and
+	// false if the deque is empty.
+	PollLast() (*T, bool)
+
+	// PeekFirst returns the head of the deque without removing it. Returns nil
+	// and false if the deque is empty.
+	PeekFirst() (*T, bool)
+
+	// PeekLast returns the tail of the deque without removing it. Returns nil
+	// and false if the deque is empty.
+	PeekLast() (*T, bool)
+}
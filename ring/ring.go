@@ -0,0 +1,404 @@
+// Package ring provides a fixed-capacity circular buffer that is safe for
+// concurrent use and that, once created, never allocates when elements are
+// pushed or popped.
+package ring
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// ErrFull is returned when decoding more elements than a buffer can hold.
+var ErrFull = errors.New("ring: buffer is full")
+
+// Policy decides what happens when an element is pushed into a full buffer.
+type Policy int
+
+const (
+	// Overwrite makes room by dropping the element at the opposite end of the
+	// buffer: pushing to the back drops the oldest element, and pushing to
+	// the front drops the newest.
+	Overwrite Policy = iota
+
+	// Reject leaves the buffer unchanged and makes the push return false.
+	Reject
+
+	// Block makes the push wait until another goroutine pops an element or
+	// clears the buffer.
+	Block
+)
+
+func (p Policy) String() string {
+	switch p {
+	case Overwrite:
+		return "Overwrite"
+	case Reject:
+		return "Reject"
+	case Block:
+		return "Block"
+	}
+	return fmt.Sprintf("Policy(%d)", int(p))
+}
+
+// Buffer is a fixed-capacity double-ended queue backed by an array. Its front
+// holds the oldest element and its back the newest, and Values, All and
+// Iterator visit the elements from the oldest to the newest.
+//
+// Unlike other collections, Pop, Peek and Get return elements by value, so
+// that steady-state use of the buffer does not allocate. Iterator and All
+// iterate over a snapshot of the buffer, and the iterator does not support
+// Remove.
+type Buffer[T any] interface {
+	base.Collection[T]
+
+	// PushBack adds an element after the newest element. If the buffer is
+	// full, the policy of the buffer decides what happens. Returns false if
+	// the element is rejected.
+	PushBack(element T) bool
+
+	// PushFront adds an element before the oldest element. If the buffer is
+	// full, the policy of the buffer decides what happens. Returns false if
+	// the element is rejected.
+	PushFront(element T) bool
+
+	// PopFront removes and returns the oldest element. Returns the zero value
+	// and false if the buffer is empty.
+	PopFront() (T, bool)
+
+	// PopBack removes and returns the newest element. Returns the zero value
+	// and false if the buffer is empty.
+	PopBack() (T, bool)
+
+	// PeekFront returns the oldest element without removing it. Returns the
+	// zero value and false if the buffer is empty.
+	PeekFront() (T, bool)
+
+	// PeekBack returns the newest element without removing it. Returns the
+	// zero value and false if the buffer is empty.
+	PeekBack() (T, bool)
+
+	// Get returns the element at the specified position, counted from the
+	// oldest element. Returns the zero value and false if the index is out of
+	// range (index < 0 || index >= Size()).
+	Get(index int) (T, bool)
+
+	// Cap returns the maximum number of elements the buffer holds.
+	Cap() int
+
+	// IsFull returns true if the buffer holds Cap elements.
+	IsFull() bool
+
+	// Policy returns the policy applied when pushing into a full buffer.
+	Policy() Policy
+
+	// Snapshot returns a copy of the elements of the buffer, from the oldest
+	// to the newest, taken atomically with respect to other operations. It is
+	// equivalent to Values.
+	Snapshot() []T
+}
+
+type buffer[T any] struct {
+	mu       sync.Mutex
+	notFull  sync.Cond
+	elements []T
+	head     int
+	size     int
+	policy   Policy
+	equal    func(a, b T) bool
+}
+
+// New returns an empty Buffer that holds at most capacity elements and
+// applies the given policy when it is full. Elements are compared using
+// reflect.DeepEqual. It panics if capacity is not positive.
+func New[T any](capacity int, policy Policy) Buffer[T] {
+	return NewFunc(capacity, policy, base.DeepEqual[T])
+}
+
+// NewFunc returns an empty Buffer that compares elements using the given
+// equal function. If equal is nil, reflect.DeepEqual is used.
+func NewFunc[T any](capacity int, policy Policy, equal func(a, b T) bool) Buffer[T] {
+	if capacity <= 0 {
+		panic("ring: capacity must be positive")
+	}
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	b := &buffer[T]{elements: make([]T, capacity), policy: policy, equal: equal}
+	b.notFull.L = &b.mu
+	return b
+}
+
+// NewComparable returns an empty Buffer that compares elements using the ==
+// operator.
+func NewComparable[T comparable](capacity int, policy Policy) Buffer[T] {
+	return NewFunc(capacity, policy, base.Equal[T])
+}
+
+// index maps the position of an element, counted from the oldest, to its
+// index in the array.
+func (b *buffer[T]) index(position int) int {
+	return (b.head + position) % len(b.elements)
+}
+
+// wait blocks while the buffer is full if its policy is Block, and reports
+// whether an element can be pushed, which it cannot only if the buffer is full
+// and its policy is Reject. It must be called with the mutex held.
+func (b *buffer[T]) wait() bool {
+	for b.size == len(b.elements) {
+		switch b.policy {
+		case Block:
+			b.notFull.Wait()
+		case Reject:
+			return false
+		default:
+			return true
+		}
+	}
+	return true
+}
+
+func (b *buffer[T]) PushBack(element T) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.wait() {
+		return false
+	}
+	if b.size == len(b.elements) {
+		b.elements[b.head] = element
+		b.head = b.index(1)
+		return true
+	}
+	b.elements[b.index(b.size)] = element
+	b.size++
+	return true
+}
+
+func (b *buffer[T]) PushFront(element T) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.wait() {
+		return false
+	}
+	// When the buffer is full, the slot before the head holds the newest
+	// element, which is then overwritten.
+	b.head = b.index(len(b.elements) - 1)
+	b.elements[b.head] = element
+	if b.size < len(b.elements) {
+		b.size++
+	}
+	return true
+}
+
+func (b *buffer[T]) PopFront() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var zero T
+	if b.size == 0 {
+		return zero, false
+	}
+	removed := b.elements[b.head]
+	b.elements[b.head] = zero
+	b.head = b.index(1)
+	b.size--
+	b.notFull.Signal()
+	return removed, true
+}
+
+func (b *buffer[T]) PopBack() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var zero T
+	if b.size == 0 {
+		return zero, false
+	}
+	last := b.index(b.size - 1)
+	removed := b.elements[last]
+	b.elements[last] = zero
+	b.size--
+	b.notFull.Signal()
+	return removed, true
+}
+
+func (b *buffer[T]) PeekFront() (T, bool) {
+	return b.Get(0)
+}
+
+func (b *buffer[T]) PeekBack() (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var zero T
+	if b.size == 0 {
+		return zero, false
+	}
+	return b.elements[b.index(b.size-1)], true
+}
+
+func (b *buffer[T]) Get(index int) (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if index < 0 || index >= b.size {
+		var zero T
+		return zero, false
+	}
+	return b.elements[b.index(index)], true
+}
+
+func (b *buffer[T]) Cap() int {
+	return len(b.elements)
+}
+
+func (b *buffer[T]) IsFull() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.size == len(b.elements)
+}
+
+func (b *buffer[T]) Policy() Policy {
+	return b.policy
+}
+
+func (b *buffer[T]) Contains(element T) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i := 0; i < b.size; i++ {
+		if b.equal(b.elements[b.index(i)], element) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *buffer[T]) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	clear(b.elements)
+	b.head = 0
+	b.size = 0
+	b.notFull.Broadcast()
+}
+
+func (b *buffer[T]) IsEmpty() bool {
+	return b.Size() == 0
+}
+
+func (b *buffer[T]) Size() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.size
+}
+
+func (b *buffer[T]) Values() []T {
+	return b.Snapshot()
+}
+
+func (b *buffer[T]) Snapshot() []T {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	values := make([]T, b.size)
+	n := copy(values, b.elements[b.head:min(b.head+b.size, len(b.elements))])
+	copy(values[n:], b.elements[:b.size-n])
+	return values
+}
+
+func (b *buffer[T]) String() string {
+	str := "RingBuffer(["
+	for i, v := range b.Snapshot() {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", v)
+	}
+	return str + "])"
+}
+
+func (b *buffer[T]) Iterator() base.Iterator[T] {
+	return &snapshotIterator[T]{values: b.Snapshot()}
+}
+
+func (b *buffer[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range b.Snapshot() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (b *buffer[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(b.Snapshot())
+}
+
+func (b *buffer[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	return b.replace(values)
+}
+
+func (b *buffer[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(b.Snapshot())
+}
+
+func (b *buffer[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	return b.replace(values)
+}
+
+func (b *buffer[T]) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+func (b *buffer[T]) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the buffer with the decoded values, oldest
+// first. It fails with ErrFull, leaving the buffer unchanged, if there are
+// more values than the buffer can hold.
+func (b *buffer[T]) replace(values []T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(values) > len(b.elements) {
+		return fmt.Errorf("%w: %d elements for a capacity of %d", ErrFull, len(values), len(b.elements))
+	}
+	clear(b.elements)
+	copy(b.elements, values)
+	b.head = 0
+	b.size = len(values)
+	b.notFull.Broadcast()
+	return nil
+}
+
+// snapshotIterator iterates over a copy of the elements of a buffer taken
+// when the iterator is created. Since the buffer may be modified by other
+// goroutines in the meantime, removing through the iterator is not supported.
+type snapshotIterator[T any] struct {
+	values []T
+	cursor int
+}
+
+func (it *snapshotIterator[T]) HasNext() bool {
+	return it.cursor < len(it.values)
+}
+
+func (it *snapshotIterator[T]) Next() (*T, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	it.cursor++
+	return &it.values[it.cursor-1], true
+}
+
+func (it *snapshotIterator[T]) Remove() bool {
+	return false
+}
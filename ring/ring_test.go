@@ -0,0 +1,211 @@
+package ring
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func bufferOf(capacity int, policy Policy, elements ...int) Buffer[int] {
+	b := NewComparable[int](capacity, policy)
+	for _, e := range elements {
+		b.PushBack(e)
+	}
+	return b
+}
+
+func TestNew(t *testing.T) {
+	b := New[int](3, Reject)
+	if !b.IsEmpty() || b.Cap() != 3 || b.Policy() != Reject {
+		t.Fatalf("Expected an empty Reject buffer of capacity 3, got %v with capacity %d and policy %v", b, b.Cap(), b.Policy())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Expected a non-positive capacity to panic")
+		}
+	}()
+	New[int](0, Overwrite)
+}
+
+func TestBuffer_PushPop(t *testing.T) {
+	b := bufferOf(4, Reject, 2, 3)
+	b.PushFront(1)
+	b.PushBack(4)
+	if got := b.Snapshot(); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Fatalf("Expected [1 2 3 4], got %v", got)
+	}
+	if !b.IsFull() {
+		t.Fatalf("Expected the buffer to be full")
+	}
+
+	if v, ok := b.PopFront(); !ok || v != 1 {
+		t.Fatalf("Expected PopFront to return 1, got %v", v)
+	}
+	if v, ok := b.PopBack(); !ok || v != 4 {
+		t.Fatalf("Expected PopBack to return 4, got %v", v)
+	}
+	if v, ok := b.PeekFront(); !ok || v != 2 {
+		t.Fatalf("Expected PeekFront to return 2, got %v", v)
+	}
+	if v, ok := b.PeekBack(); !ok || v != 3 {
+		t.Fatalf("Expected PeekBack to return 3, got %v", v)
+	}
+
+	b.Clear()
+	if _, ok := b.PopFront(); ok {
+		t.Fatalf("Expected PopFront on an empty buffer to fail")
+	}
+	if _, ok := b.PopBack(); ok {
+		t.Fatalf("Expected PopBack on an empty buffer to fail")
+	}
+	if _, ok := b.PeekBack(); ok {
+		t.Fatalf("Expected PeekBack on an empty buffer to fail")
+	}
+}
+
+func TestBuffer_Get(t *testing.T) {
+	b := bufferOf(3, Overwrite, 1, 2, 3, 4, 5)
+	scenarios := []struct {
+		index    int
+		expected int
+		ok       bool
+	}{
+		{index: 0, expected: 3, ok: true},
+		{index: 2, expected: 5, ok: true},
+		{index: 3, ok: false},
+		{index: -1, ok: false},
+	}
+	for _, s := range scenarios {
+		if v, ok := b.Get(s.index); ok != s.ok || v != s.expected {
+			t.Fatalf("Expected Get(%d) to return (%d, %v), got (%d, %v)", s.index, s.expected, s.ok, v, ok)
+		}
+	}
+}
+
+func TestBuffer_Overwrite(t *testing.T) {
+	b := bufferOf(3, Overwrite, 1, 2, 3)
+	if !b.PushBack(4) {
+		t.Fatalf("Expected PushBack to succeed when overwriting")
+	}
+	if got := b.Snapshot(); !slices.Equal(got, []int{2, 3, 4}) {
+		t.Fatalf("Expected PushBack to drop the oldest element, got %v", got)
+	}
+	b.PushFront(0)
+	if got := b.Snapshot(); !slices.Equal(got, []int{0, 2, 3}) {
+		t.Fatalf("Expected PushFront to drop the newest element, got %v", got)
+	}
+	if b.Size() != 3 {
+		t.Fatalf("Expected size 3, got %d", b.Size())
+	}
+}
+
+func TestBuffer_Reject(t *testing.T) {
+	b := bufferOf(2, Reject, 1, 2)
+	if b.PushBack(3) || b.PushFront(0) {
+		t.Fatalf("Expected pushing into a full buffer to be rejected")
+	}
+	if got := b.Snapshot(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("Expected the buffer to be unchanged, got %v", got)
+	}
+}
+
+func TestBuffer_Block(t *testing.T) {
+	b := bufferOf(2, Block, 1, 2)
+	pushed := make(chan bool)
+	go func() {
+		pushed <- b.PushBack(3)
+	}()
+
+	select {
+	case <-pushed:
+		t.Fatalf("Expected PushBack to block while the buffer is full")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	if v, _ := b.PopFront(); v != 1 {
+		t.Fatalf("Expected PopFront to return 1, got %d", v)
+	}
+	if !<-pushed {
+		t.Fatalf("Expected the blocked PushBack to succeed")
+	}
+	if got := b.Snapshot(); !slices.Equal(got, []int{2, 3}) {
+		t.Fatalf("Expected [2 3], got %v", got)
+	}
+}
+
+func TestBuffer_Collection(t *testing.T) {
+	b := bufferOf(3, Overwrite, 1, 2, 3, 4)
+	if !b.Contains(4) || b.Contains(1) {
+		t.Fatalf("Expected the buffer to contain 4 but not 1")
+	}
+	if got := b.String(); got != "RingBuffer([2, 3, 4])" {
+		t.Fatalf("Expected RingBuffer([2, 3, 4]), got %s", got)
+	}
+
+	var values []int
+	for v := range b.All() {
+		values = append(values, v)
+	}
+	if !slices.Equal(values, []int{2, 3, 4}) {
+		t.Fatalf("Expected All to visit [2 3 4], got %v", values)
+	}
+
+	it := b.Iterator()
+	if v, _ := it.Next(); *v != 2 || it.Remove() {
+		t.Fatalf("Expected the iterator to start at 2 and not support Remove")
+	}
+}
+
+func TestBuffer_JSON(t *testing.T) {
+	b := bufferOf(3, Overwrite, 1, 2, 3, 4)
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(data) != "[2,3,4]" {
+		t.Fatalf("Expected [2,3,4], got %s", data)
+	}
+
+	decoded := New[int](3, Overwrite)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := decoded.Snapshot(); !slices.Equal(got, []int{2, 3, 4}) {
+		t.Fatalf("Expected [2 3 4], got %v", got)
+	}
+
+	small := New[int](2, Overwrite)
+	if err := json.Unmarshal(data, small); !errors.Is(err, ErrFull) {
+		t.Fatalf("Expected ErrFull when decoding too many elements, got %v", err)
+	}
+}
+
+func TestBuffer_ZeroAllocations(t *testing.T) {
+	b := New[int](8, Overwrite)
+	allocations := testing.AllocsPerRun(100, func() {
+		for i := range 16 {
+			b.PushBack(i)
+			b.PushFront(i)
+		}
+		b.Get(3)
+		b.PopFront()
+		b.PopBack()
+	})
+	if allocations != 0 {
+		t.Fatalf("Expected pushing and popping to not allocate, got %v allocations", allocations)
+	}
+}
+
+func BenchmarkBuffer_PushPop(b *testing.B) {
+	b.ReportAllocs()
+	buffer := New[int](1024, Overwrite)
+	for i := range b.N {
+		buffer.PushBack(i)
+		if i%2 == 0 {
+			buffer.PopFront()
+		}
+	}
+}
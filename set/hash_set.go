@@ -0,0 +1,244 @@
+package set
+
+import (
+	"fmt"
+	"hash/maphash"
+	"iter"
+	"slices"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+	"github.com/elias8/go-gather/internal/hashing"
+)
+
+// hashSet is backed by a Go map from the hashes of the elements to the
+// elements with that hash, so that it can use any equality strategy.
+type hashSet[T any] struct {
+	buckets map[uint64][]T
+	size    int
+	seed    maphash.Seed
+	equal   func(a, b T) bool
+	hash    func(seed maphash.Seed, v T) uint64
+}
+
+// NewHashSet returns an empty Set backed by a Go map. Elements are compared
+// using the == operator and hashed using maphash.Comparable. The iteration
+// order of the set is unspecified.
+func NewHashSet[T comparable]() Set[T] {
+	return NewHashSetFunc(base.Equal[T], base.Hash[T])
+}
+
+// NewHashSetFunc returns an empty Set backed by a Go map that compares
+// elements using the given equal function and hashes them using the given
+// hash function, which must return the same hash for elements that equal
+// reports equal. If equal is nil, reflect.DeepEqual is used, and if hash is
+// nil, base.DeepHash is used.
+func NewHashSetFunc[T any](equal func(a, b T) bool, hash func(seed maphash.Seed, v T) uint64) Set[T] {
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	if hash == nil {
+		hash = base.DeepHash[T]
+	}
+	return &hashSet[T]{buckets: make(map[uint64][]T), seed: maphash.MakeSeed(), equal: equal, hash: hash}
+}
+
+func (s *hashSet[T]) Size() int {
+	return s.size
+}
+
+func (s *hashSet[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+func (s *hashSet[T]) Contains(element T) bool {
+	_, i := s.find(element)
+	return i >= 0
+}
+
+// find returns the hash of the element and its index in the bucket of that
+// hash, or -1 if the set does not contain it.
+func (s *hashSet[T]) find(element T) (uint64, int) {
+	h := s.hash(s.seed, element)
+	for i, e := range s.buckets[h] {
+		if s.equal(e, element) {
+			return h, i
+		}
+	}
+	return h, -1
+}
+
+func (s *hashSet[T]) Values() []T {
+	var values []T
+	for e := range s.All() {
+		values = append(values, e)
+	}
+	return values
+}
+
+func (s *hashSet[T]) Clear() {
+	clear(s.buckets)
+	s.size = 0
+}
+
+func (s *hashSet[T]) String() string {
+	str := "HashSet(["
+	i := 0
+	for e := range s.All() {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", e)
+		i++
+	}
+	return str + "])"
+}
+
+func (s *hashSet[T]) Iterator() base.Iterator[T] {
+	return &hashSetIterator[T]{set: s, values: s.Values(), cursor: -1}
+}
+
+func (s *hashSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, bucket := range s.buckets {
+			for _, e := range bucket {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *hashSet[T]) Add(element T) bool {
+	h, i := s.find(element)
+	if i >= 0 {
+		return false
+	}
+	s.buckets[h] = append(s.buckets[h], element)
+	s.size++
+	return true
+}
+
+func (s *hashSet[T]) Remove(element T) bool {
+	h, i := s.find(element)
+	if i < 0 {
+		return false
+	}
+	if bucket := slices.Delete(s.buckets[h], i, i+1); len(bucket) > 0 {
+		s.buckets[h] = bucket
+	} else {
+		delete(s.buckets, h)
+	}
+	s.size--
+	return true
+}
+
+func (s *hashSet[T]) ContainsAll(elements ...T) bool {
+	return containsAll[T](s, elements)
+}
+
+func (s *hashSet[T]) Union(other Set[T]) Set[T] {
+	return union[T](s, other, s.empty())
+}
+
+func (s *hashSet[T]) Intersection(other Set[T]) Set[T] {
+	return intersection[T](s, other, s.empty())
+}
+
+func (s *hashSet[T]) Difference(other Set[T]) Set[T] {
+	return difference[T](s, other, s.empty())
+}
+
+func (s *hashSet[T]) SymmetricDifference(other Set[T]) Set[T] {
+	return symmetricDifference[T](s, other, s.empty())
+}
+
+func (s *hashSet[T]) IsSubsetOf(other Set[T]) bool {
+	return isSubsetOf[T](s, other)
+}
+
+// empty returns an empty set with the same equality strategy.
+func (s *hashSet[T]) empty() *hashSet[T] {
+	return NewHashSetFunc(s.equal, s.hash).(*hashSet[T])
+}
+
+// hashSetIterator iterates over a snapshot of the elements, since a Go map
+// cannot be traversed step by step.
+type hashSetIterator[T any] struct {
+	set     *hashSet[T]
+	values  []T
+	cursor  int
+	removed bool
+}
+
+func (it *hashSetIterator[T]) HasNext() bool {
+	return it.cursor+1 < len(it.values)
+}
+
+func (it *hashSetIterator[T]) Next() (*T, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	it.cursor++
+	it.removed = false
+	return &it.values[it.cursor], true
+}
+
+func (it *hashSetIterator[T]) Remove() bool {
+	if it.cursor < 0 || it.removed {
+		return false
+	}
+	it.removed = true
+	return it.set.Remove(it.values[it.cursor])
+}
+
+func (s *hashSet[T]) Equals(other base.Collection[T]) bool {
+	return equals[T](s, other)
+}
+
+func (s *hashSet[T]) Hash(seed maphash.Seed) uint64 {
+	return hashing.Unordered(seed, s.All(), s.hash)
+}
+
+func (s *hashSet[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(s.Values())
+}
+
+func (s *hashSet[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *hashSet[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(s.Values())
+}
+
+func (s *hashSet[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *hashSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+func (s *hashSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the set with the decoded values.
+func (s *hashSet[T]) replace(values []T) {
+	s.Clear()
+	for _, v := range values {
+		s.Add(v)
+	}
+}
@@ -0,0 +1,236 @@
+package set
+
+import (
+	"encoding/json"
+	"hash/maphash"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/elias8/go-gather/base"
+)
+
+func hashSetOf(elements ...int) Set[int] {
+	s := NewHashSet[int]()
+	for _, e := range elements {
+		s.Add(e)
+	}
+	return s
+}
+
+func sorted(s Set[int]) []int {
+	values := s.Values()
+	slices.Sort(values)
+	return values
+}
+
+func TestNewHashSet(t *testing.T) {
+	s := NewHashSet[int]()
+	if s == nil {
+		t.Fatalf("Expected NewHashSet() to return a Set, got nil")
+	}
+	if !s.IsEmpty() || s.Size() != 0 {
+		t.Fatalf("Expected NewHashSet() to return an empty Set")
+	}
+}
+
+func TestNewHashSetFunc(t *testing.T) {
+	newSet := func() Set[string] {
+		return NewHashSetFunc(
+			func(a, b string) bool { return strings.EqualFold(a, b) },
+			func(seed maphash.Seed, s string) uint64 { return maphash.String(seed, strings.ToLower(s)) },
+		)
+	}
+	s := newSet()
+	s.Add("A")
+	s.Add("b")
+	if s.Add("a") || !s.Contains("B") {
+		t.Fatalf("Expected elements to be compared ignoring case, got %v", s)
+	}
+	if union := s.Union(NewHashSet[string]()); union.Add("B") {
+		t.Fatalf("Expected Union to keep the equality of the set")
+	}
+
+	other := newSet()
+	other.Add("B")
+	other.Add("a")
+	seed := maphash.MakeSeed()
+	if !s.Equals(other) || s.Hash(seed) != other.Hash(seed) {
+		t.Fatalf("Expected sets equal ignoring case to be equal and hash alike")
+	}
+	if !s.Remove("a") || s.Size() != 1 {
+		t.Fatalf("Expected Remove(a) to remove A, got %v", s)
+	}
+
+	slicesSet := NewHashSetFunc[[]int](nil, nil)
+	slicesSet.Add([]int{1, 2})
+	if !slicesSet.Contains([]int{1, 2}) {
+		t.Fatalf("Expected nil functions to fall back to deep equality")
+	}
+}
+
+func TestHashSet_Collisions(t *testing.T) {
+	s := NewHashSetFunc(base.Equal[int], func(maphash.Seed, int) uint64 { return 0 })
+	for i := range 10 {
+		s.Add(i)
+	}
+	for i := 0; i < 10; i += 2 {
+		if !s.Remove(i) {
+			t.Fatalf("Expected Remove(%v) to return true", i)
+		}
+	}
+	if values := sorted(s); !slices.Equal(values, []int{1, 3, 5, 7, 9}) || s.Contains(4) {
+		t.Fatalf("Expected [1 3 5 7 9], got %v", values)
+	}
+}
+
+func TestHashSet_Add(t *testing.T) {
+	s := NewHashSet[int]()
+	if !s.Add(1) {
+		t.Fatalf("Expected 1 to be added")
+	}
+	if s.Add(1) {
+		t.Fatalf("Expected duplicate 1 to not be added")
+	}
+	if !s.Add(2) {
+		t.Fatalf("Expected 2 to be added")
+	}
+	if s.Size() != 2 {
+		t.Fatalf("Expected size 2, got %d", s.Size())
+	}
+}
+
+func TestHashSet_Remove(t *testing.T) {
+	s := hashSetOf(1, 2, 3)
+	if !s.Remove(2) {
+		t.Fatalf("Expected 2 to be removed")
+	}
+	if s.Remove(2) {
+		t.Fatalf("Expected 2 to not be removed twice")
+	}
+	if s.Contains(2) {
+		t.Fatalf("Expected set to not contain 2")
+	}
+	if values := sorted(s); !slices.Equal(values, []int{1, 3}) {
+		t.Fatalf("Expected [1 3], got %v", values)
+	}
+}
+
+func TestHashSet_ContainsAll(t *testing.T) {
+	s := hashSetOf(1, 2, 3)
+	if !s.ContainsAll(1, 3) {
+		t.Fatalf("Expected set to contain 1 and 3")
+	}
+	if s.ContainsAll(1, 4) {
+		t.Fatalf("Expected set to not contain 4")
+	}
+	if !s.ContainsAll() {
+		t.Fatalf("Expected set to contain all of no elements")
+	}
+}
+
+func TestHashSet_Operations(t *testing.T) {
+	scenarios := []struct {
+		name      string
+		operation func(a, b Set[int]) Set[int]
+		expected  []int
+	}{
+		{name: "union", operation: Set[int].Union, expected: []int{1, 2, 3, 4, 5}},
+		{name: "intersection", operation: Set[int].Intersection, expected: []int{3}},
+		{name: "difference", operation: Set[int].Difference, expected: []int{1, 2}},
+		{name: "symmetric difference", operation: Set[int].SymmetricDifference, expected: []int{1, 2, 4, 5}},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			a := hashSetOf(1, 2, 3)
+			b := hashSetOf(3, 4, 5)
+			result := s.operation(a, b)
+			if values := sorted(result); !slices.Equal(values, s.expected) {
+				t.Fatalf("Expected %v, got %v", s.expected, values)
+			}
+			if values := sorted(a); !slices.Equal(values, []int{1, 2, 3}) {
+				t.Fatalf("Expected the receiver to be left unchanged, got %v", values)
+			}
+		})
+	}
+}
+
+func TestHashSet_IsSubsetOf(t *testing.T) {
+	if !hashSetOf(1, 2).IsSubsetOf(hashSetOf(1, 2, 3)) {
+		t.Fatalf("Expected [1 2] to be a subset of [1 2 3]")
+	}
+	if hashSetOf(1, 4).IsSubsetOf(hashSetOf(1, 2, 3)) {
+		t.Fatalf("Expected [1 4] to not be a subset of [1 2 3]")
+	}
+	if !NewHashSet[int]().IsSubsetOf(hashSetOf()) {
+		t.Fatalf("Expected the empty set to be a subset of the empty set")
+	}
+}
+
+func TestHashSet_Iterator(t *testing.T) {
+	s := hashSetOf(1, 2, 3, 4)
+	it := s.Iterator()
+	if it.Remove() {
+		t.Fatalf("Expected Remove to fail before calling Next")
+	}
+	for it.HasNext() {
+		if e, _ := it.Next(); *e%2 == 0 {
+			if !it.Remove() {
+				t.Fatalf("Expected %d to be removed", *e)
+			}
+			if it.Remove() {
+				t.Fatalf("Expected Remove to fail twice in a row")
+			}
+		}
+	}
+	if values := sorted(s); !slices.Equal(values, []int{1, 3}) {
+		t.Fatalf("Expected [1 3], got %v", values)
+	}
+}
+
+func TestHashSet_All(t *testing.T) {
+	s := hashSetOf(1, 2, 3)
+	values := slices.Sorted(s.All())
+	if !slices.Equal(values, []int{1, 2, 3}) {
+		t.Fatalf("Expected [1 2 3], got %v", values)
+	}
+}
+
+func TestHashSet_Clear(t *testing.T) {
+	s := hashSetOf(1, 2, 3)
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Fatalf("Expected set to be empty after clearing")
+	}
+}
+
+func TestHashSet_String(t *testing.T) {
+	if s := hashSetOf(1).String(); s != "HashSet([1])" {
+		t.Fatalf("Expected HashSet([1]), got %s", s)
+	}
+}
+
+func TestHashSet_JSON(t *testing.T) {
+	s := hashSetOf(1, 2, 3)
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	decoded := NewHashSet[int]()
+	if err := json.Unmarshal([]byte("[3, 1, 3, 2]"), decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if decoded.Size() != 3 || !decoded.ContainsAll(1, 2, 3) {
+		t.Fatalf("Expected duplicates to collapse into {1, 2, 3}, but got %v", decoded)
+	}
+
+	decoded = NewHashSet[int]()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if !decoded.IsSubsetOf(s) || !s.IsSubsetOf(decoded) {
+		t.Fatalf("Expected %v to round trip, but got %v", s, decoded)
+	}
+}
@@ -0,0 +1,236 @@
+package set
+
+import (
+	"fmt"
+	"hash/maphash"
+	"iter"
+	"slices"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+	"github.com/elias8/go-gather/internal/hashing"
+	"github.com/elias8/go-gather/internal/linked"
+	"github.com/elias8/go-gather/list"
+)
+
+// linkedHashSet is a hashSet that additionally keeps its elements in a linked
+// list to remember the order in which they were inserted. The buckets hold the
+// elements of the list, so that removing an element unlinks it in O(1) time.
+type linkedHashSet[T any] struct {
+	buckets map[uint64][]*list.Element[T]
+	order   *linked.List[T]
+	seed    maphash.Seed
+	equal   func(a, b T) bool
+	hash    func(seed maphash.Seed, v T) uint64
+}
+
+// NewLinkedHashSet returns an empty Set that iterates over its elements in
+// insertion order. Re-adding an element does not change its position.
+// Elements are compared using the == operator and hashed using
+// maphash.Comparable.
+func NewLinkedHashSet[T comparable]() Set[T] {
+	return NewLinkedHashSetFunc(base.Equal[T], base.Hash[T])
+}
+
+// NewLinkedHashSetFunc returns an empty insertion-ordered Set that compares
+// elements using the given equal function and hashes them using the given
+// hash function, as NewHashSetFunc does. If equal is nil, reflect.DeepEqual is
+// used, and if hash is nil, base.DeepHash is used.
+func NewLinkedHashSetFunc[T any](equal func(a, b T) bool, hash func(seed maphash.Seed, v T) uint64) Set[T] {
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	if hash == nil {
+		hash = base.DeepHash[T]
+	}
+	return &linkedHashSet[T]{
+		buckets: make(map[uint64][]*list.Element[T]),
+		order:   linked.New[T](),
+		seed:    maphash.MakeSeed(),
+		equal:   equal,
+		hash:    hash,
+	}
+}
+
+func (s *linkedHashSet[T]) Size() int {
+	return s.order.Size()
+}
+
+func (s *linkedHashSet[T]) IsEmpty() bool {
+	return s.order.Size() == 0
+}
+
+func (s *linkedHashSet[T]) Contains(element T) bool {
+	_, i := s.find(element)
+	return i >= 0
+}
+
+// find returns the hash of the element and its index in the bucket of that
+// hash, or -1 if the set does not contain it.
+func (s *linkedHashSet[T]) find(element T) (uint64, int) {
+	h := s.hash(s.seed, element)
+	for i, e := range s.buckets[h] {
+		if s.equal(e.Value, element) {
+			return h, i
+		}
+	}
+	return h, -1
+}
+
+func (s *linkedHashSet[T]) Values() []T {
+	return s.order.Values()
+}
+
+func (s *linkedHashSet[T]) Clear() {
+	clear(s.buckets)
+	s.order.Clear()
+}
+
+func (s *linkedHashSet[T]) String() string {
+	str := "LinkedHashSet(["
+	for current := s.order.Front(); current != nil; current = current.Next() {
+		if current != s.order.Front() {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", current.Value)
+	}
+	return str + "])"
+}
+
+func (s *linkedHashSet[T]) Iterator() base.Iterator[T] {
+	return &linkedHashSetIterator[T]{set: s, next: s.order.Front()}
+}
+
+func (s *linkedHashSet[T]) All() iter.Seq[T] {
+	return s.order.All()
+}
+
+func (s *linkedHashSet[T]) Add(element T) bool {
+	h, i := s.find(element)
+	if i >= 0 {
+		return false
+	}
+	s.buckets[h] = append(s.buckets[h], s.order.PushBack(element))
+	return true
+}
+
+func (s *linkedHashSet[T]) Remove(element T) bool {
+	h, i := s.find(element)
+	if i < 0 {
+		return false
+	}
+	s.order.Remove(s.buckets[h][i])
+	if bucket := slices.Delete(s.buckets[h], i, i+1); len(bucket) > 0 {
+		s.buckets[h] = bucket
+	} else {
+		delete(s.buckets, h)
+	}
+	return true
+}
+
+func (s *linkedHashSet[T]) ContainsAll(elements ...T) bool {
+	return containsAll[T](s, elements)
+}
+
+func (s *linkedHashSet[T]) Union(other Set[T]) Set[T] {
+	return union[T](s, other, s.empty())
+}
+
+func (s *linkedHashSet[T]) Intersection(other Set[T]) Set[T] {
+	return intersection[T](s, other, s.empty())
+}
+
+func (s *linkedHashSet[T]) Difference(other Set[T]) Set[T] {
+	return difference[T](s, other, s.empty())
+}
+
+func (s *linkedHashSet[T]) SymmetricDifference(other Set[T]) Set[T] {
+	return symmetricDifference[T](s, other, s.empty())
+}
+
+func (s *linkedHashSet[T]) IsSubsetOf(other Set[T]) bool {
+	return isSubsetOf[T](s, other)
+}
+
+// empty returns an empty set with the same equality strategy.
+func (s *linkedHashSet[T]) empty() *linkedHashSet[T] {
+	return NewLinkedHashSetFunc(s.equal, s.hash).(*linkedHashSet[T])
+}
+
+type linkedHashSetIterator[T any] struct {
+	set  *linkedHashSet[T]
+	next *list.Element[T]
+	last *list.Element[T]
+}
+
+func (it *linkedHashSetIterator[T]) HasNext() bool {
+	return it.next != nil
+}
+
+func (it *linkedHashSetIterator[T]) Next() (*T, bool) {
+	if it.next == nil {
+		return nil, false
+	}
+	it.last = it.next
+	it.next = it.next.Next()
+	return &it.last.Value, true
+}
+
+func (it *linkedHashSetIterator[T]) Remove() bool {
+	if it.last == nil {
+		return false
+	}
+	removed := it.set.Remove(it.last.Value)
+	it.last = nil
+	return removed
+}
+
+func (s *linkedHashSet[T]) Equals(other base.Collection[T]) bool {
+	return equals[T](s, other)
+}
+
+func (s *linkedHashSet[T]) Hash(seed maphash.Seed) uint64 {
+	return hashing.Unordered(seed, s.All(), s.hash)
+}
+
+func (s *linkedHashSet[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(s.Values())
+}
+
+func (s *linkedHashSet[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *linkedHashSet[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(s.Values())
+}
+
+func (s *linkedHashSet[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *linkedHashSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+func (s *linkedHashSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the set with the decoded values.
+func (s *linkedHashSet[T]) replace(values []T) {
+	s.Clear()
+	for _, v := range values {
+		s.Add(v)
+	}
+}
@@ -0,0 +1,170 @@
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"hash/maphash"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func linkedHashSetOf(elements ...int) Set[int] {
+	s := NewLinkedHashSet[int]()
+	for _, e := range elements {
+		s.Add(e)
+	}
+	return s
+}
+
+func TestNewLinkedHashSet(t *testing.T) {
+	s := NewLinkedHashSet[int]()
+	if !s.IsEmpty() || s.Size() != 0 {
+		t.Fatalf("Expected NewLinkedHashSet() to return an empty Set")
+	}
+}
+
+func TestNewLinkedHashSetFunc(t *testing.T) {
+	s := NewLinkedHashSetFunc(
+		func(a, b string) bool { return strings.EqualFold(a, b) },
+		func(seed maphash.Seed, s string) uint64 { return maphash.String(seed, strings.ToLower(s)) },
+	)
+	for _, e := range []string{"c", "A", "b", "a", "C"} {
+		s.Add(e)
+	}
+	if values := s.Values(); !slices.Equal(values, []string{"c", "A", "b"}) {
+		t.Fatalf("Expected [c A b], got %v", values)
+	}
+	s.Remove("a")
+	if values := s.Intersection(s).Values(); !slices.Equal(values, []string{"c", "b"}) {
+		t.Fatalf("Expected [c b], got %v", values)
+	}
+}
+
+func TestLinkedHashSet_InsertionOrder(t *testing.T) {
+	s := linkedHashSetOf(3, 1, 2, 1, 3)
+	if values := s.Values(); !slices.Equal(values, []int{3, 1, 2}) {
+		t.Fatalf("Expected [3 1 2], got %v", values)
+	}
+	if s.String() != "LinkedHashSet([3, 1, 2])" {
+		t.Fatalf("Expected LinkedHashSet([3, 1, 2]), got %s", s.String())
+	}
+}
+
+func TestLinkedHashSet_Remove(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		element  int
+		removed  bool
+		expected []int
+	}{
+		{name: "remove head", element: 1, removed: true, expected: []int{2, 3}},
+		{name: "remove middle", element: 2, removed: true, expected: []int{1, 3}},
+		{name: "remove tail", element: 3, removed: true, expected: []int{1, 2}},
+		{name: "remove missing", element: 4, removed: false, expected: []int{1, 2, 3}},
+	}
+
+	for _, sc := range scenarios {
+		t.Run(sc.name, func(t *testing.T) {
+			s := linkedHashSetOf(1, 2, 3)
+			if removed := s.Remove(sc.element); removed != sc.removed {
+				t.Fatalf("Expected Remove(%d) to return %v", sc.element, sc.removed)
+			}
+			if values := s.Values(); !slices.Equal(values, sc.expected) {
+				t.Fatalf("Expected %v, got %v", sc.expected, values)
+			}
+			s.Add(4)
+			if values := slices.Collect(s.All()); !slices.Equal(values, append(sc.expected, 4)) {
+				t.Fatalf("Expected %v, got %v", append(sc.expected, 4), values)
+			}
+		})
+	}
+}
+
+func TestLinkedHashSet_RemoveWhileRanging(t *testing.T) {
+	s := linkedHashSetOf(1, 2, 3)
+	var visited []int
+	for e := range s.All() {
+		visited = append(visited, e)
+		if e == 1 {
+			s.Remove(2)
+		}
+	}
+	if !slices.Equal(visited, []int{1, 3}) {
+		t.Fatalf("Expected removing the next element while ranging to skip it, got %v", visited)
+	}
+
+	for e := range s.All() {
+		s.Remove(e)
+	}
+	if !s.IsEmpty() {
+		t.Fatalf("Expected removing every element while ranging to empty the set, got %v", s)
+	}
+}
+
+func TestLinkedHashSet_Operations(t *testing.T) {
+	a := linkedHashSetOf(1, 2, 3)
+	b := linkedHashSetOf(4, 3)
+
+	if values := a.Union(b).Values(); !slices.Equal(values, []int{1, 2, 3, 4}) {
+		t.Fatalf("Expected union [1 2 3 4], got %v", values)
+	}
+	if values := a.Intersection(b).Values(); !slices.Equal(values, []int{3}) {
+		t.Fatalf("Expected intersection [3], got %v", values)
+	}
+	if values := a.Difference(b).Values(); !slices.Equal(values, []int{1, 2}) {
+		t.Fatalf("Expected difference [1 2], got %v", values)
+	}
+	if values := a.SymmetricDifference(b).Values(); !slices.Equal(values, []int{1, 2, 4}) {
+		t.Fatalf("Expected symmetric difference [1 2 4], got %v", values)
+	}
+	if !a.Intersection(b).IsSubsetOf(a) {
+		t.Fatalf("Expected intersection to be a subset of the set")
+	}
+	if !a.ContainsAll(3, 2) || a.ContainsAll(4) {
+		t.Fatalf("Expected ContainsAll to report membership of every element")
+	}
+}
+
+func TestLinkedHashSet_Iterator(t *testing.T) {
+	s := linkedHashSetOf(1, 2, 3, 4)
+	it := s.Iterator()
+	for it.HasNext() {
+		if e, _ := it.Next(); *e%2 == 1 {
+			it.Remove()
+		}
+	}
+	if values := s.Values(); !slices.Equal(values, []int{2, 4}) {
+		t.Fatalf("Expected [2 4], got %v", values)
+	}
+}
+
+func TestLinkedHashSet_Clear(t *testing.T) {
+	s := linkedHashSetOf(1, 2)
+	s.Clear()
+	if !s.IsEmpty() || s.Values() != nil {
+		t.Fatalf("Expected set to be empty after clearing")
+	}
+	s.Add(3)
+	if values := s.Values(); !slices.Equal(values, []int{3}) {
+		t.Fatalf("Expected [3], got %v", values)
+	}
+}
+
+func TestLinkedHashSet_Gob(t *testing.T) {
+	s := linkedHashSetOf(3, 1, 2)
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(s); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	decoded := NewLinkedHashSet[int]()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	expected := []int{3, 1, 2}
+	if slice := decoded.Values(); !slices.Equal(slice, expected) {
+		t.Fatalf("Expected insertion order %v to be preserved, but got %v", expected, slice)
+	}
+}
@@ -0,0 +1,168 @@
+package set
+
+import (
+	"hash/maphash"
+
+	"github.com/elias8/go-gather/base"
+)
+
+// Set is a collection that contains no duplicate elements.
+type Set[T any] interface {
+	base.Collection[T]
+
+	// Add adds the specified element to the set if it is not already present.
+	// Returns true if the element is added, false otherwise.
+	Add(element T) bool
+
+	// Remove removes the specified element from the set. Returns true if the
+	// element is removed, false otherwise.
+	Remove(element T) bool
+
+	// ContainsAll returns true if the set contains all the specified elements.
+	ContainsAll(elements ...T) bool
+
+	// Union returns a new set, of the same kind as this set, that contains the
+	// elements that are in this set or in the other set.
+	Union(other Set[T]) Set[T]
+
+	// Intersection returns a new set, of the same kind as this set, that
+	// contains the elements that are in both this set and the other set.
+	Intersection(other Set[T]) Set[T]
+
+	// Difference returns a new set, of the same kind as this set, that
+	// contains the elements that are in this set but not in the other set.
+	Difference(other Set[T]) Set[T]
+
+	// SymmetricDifference returns a new set, of the same kind as this set,
+	// that contains the elements that are in exactly one of the two sets.
+	SymmetricDifference(other Set[T]) Set[T]
+
+	// IsSubsetOf returns true if every element of this set is also in the
+	// other set.
+	IsSubsetOf(other Set[T]) bool
+
+	// Equals returns true if other is a Set of the same size all of whose
+	// elements are in this set. The order of the elements does not matter,
+	// so sets of different kinds holding the same elements are equal.
+	Equals(other base.Collection[T]) bool
+
+	// Hash returns a hash of the elements of the set, regardless of their
+	// order, computed with hash/maphash and the given seed from the hashes of
+	// the elements. Sets that are Equals and hash their elements alike have
+	// the same hash for the same seed. NewHashSet, NewLinkedHashSet and
+	// NewTreeSet hash elements using maphash.Comparable.
+	Hash(seed maphash.Seed) uint64
+}
+
+// SortedSet is a Set whose elements are kept in ascending order according to
+// a comparison function.
+//
+// First, Last, Floor, Ceiling, Lower and Higher return a pointer to a copy of
+// the element, so that it is not changed by later modifications of the set,
+// and modifying it does not affect the set.
+type SortedSet[T any] interface {
+	Set[T]
+
+	// First returns the lowest element in the set. Returns nil and false if
+	// the set is empty.
+	First() (*T, bool)
+
+	// Last returns the highest element in the set. Returns nil and false if
+	// the set is empty.
+	Last() (*T, bool)
+
+	// Floor returns the greatest element less than or equal to the specified
+	// element. Returns nil and false if there is no such element.
+	Floor(element T) (*T, bool)
+
+	// Ceiling returns the least element greater than or equal to the
+	// specified element. Returns nil and false if there is no such element.
+	Ceiling(element T) (*T, bool)
+
+	// Lower returns the greatest element strictly less than the specified
+	// element. Returns nil and false if there is no such element.
+	Lower(element T) (*T, bool)
+
+	// Higher returns the least element strictly greater than the specified
+	// element. Returns nil and false if there is no such element.
+	Higher(element T) (*T, bool)
+
+	// HeadSet returns a new set containing the elements strictly less than
+	// to.
+	HeadSet(to T) SortedSet[T]
+
+	// TailSet returns a new set containing the elements greater than or equal
+	// to from.
+	TailSet(from T) SortedSet[T]
+
+	// SubSet returns a new set containing the elements ranging from from,
+	// inclusive, to to, exclusive.
+	SubSet(from, to T) SortedSet[T]
+}
+
+func containsAll[T any](s Set[T], elements []T) bool {
+	for _, e := range elements {
+		if !s.Contains(e) {
+			return false
+		}
+	}
+	return true
+}
+
+func union[T any](s, other, result Set[T]) Set[T] {
+	for e := range s.All() {
+		result.Add(e)
+	}
+	for e := range other.All() {
+		result.Add(e)
+	}
+	return result
+}
+
+func intersection[T any](s, other, result Set[T]) Set[T] {
+	for e := range s.All() {
+		if other.Contains(e) {
+			result.Add(e)
+		}
+	}
+	return result
+}
+
+func difference[T any](s, other, result Set[T]) Set[T] {
+	for e := range s.All() {
+		if !other.Contains(e) {
+			result.Add(e)
+		}
+	}
+	return result
+}
+
+func symmetricDifference[T any](s, other, result Set[T]) Set[T] {
+	difference(s, other, result)
+	return difference(other, s, result)
+}
+
+func isSubsetOf[T any](s, other Set[T]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+	for e := range s.All() {
+		if !other.Contains(e) {
+			return false
+		}
+	}
+	return true
+}
+
+func equals[T any](s Set[T], other base.Collection[T]) bool {
+	o, ok := other.(Set[T])
+	if !ok || s.Size() != o.Size() {
+		return false
+	}
+	for e := range o.All() {
+		if !s.Contains(e) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+package set
+
+import (
+	"hash/maphash"
+	"testing"
+)
+
+func TestSet_Equals(t *testing.T) {
+	hashSet := hashSetOf(1, 2, 3)
+	linkedHashSet := NewLinkedHashSet[int]()
+	for _, e := range []int{3, 1, 2} {
+		linkedHashSet.Add(e)
+	}
+	treeSet := NewTreeSet[int]()
+	for _, e := range []int{2, 3, 1} {
+		treeSet.Add(e)
+	}
+
+	scenarios := []struct {
+		name     string
+		a        Set[int]
+		b        Set[int]
+		expected bool
+	}{
+		{name: "HashSet and LinkedHashSet", a: hashSet, b: linkedHashSet, expected: true},
+		{name: "LinkedHashSet and TreeSet", a: linkedHashSet, b: treeSet, expected: true},
+		{name: "TreeSet and HashSet", a: treeSet, b: hashSet, expected: true},
+		{name: "subset", a: hashSet, b: hashSetOf(1, 2), expected: false},
+		{name: "same size, different elements", a: treeSet, b: hashSetOf(1, 2, 4), expected: false},
+		{name: "empty sets", a: NewHashSet[int](), b: NewTreeSet[int](), expected: true},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			if got := s.a.Equals(s.b); got != s.expected {
+				t.Fatalf("Expected Equals to return %v, got %v", s.expected, got)
+			}
+		})
+	}
+}
+
+func TestSet_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	a := hashSetOf(1, 2, 3)
+	b := NewTreeSet[int]()
+	for _, e := range []int{3, 2, 1} {
+		b.Add(e)
+	}
+	if a.Hash(seed) != b.Hash(seed) {
+		t.Fatalf("Expected equal sets to have the same hash regardless of order")
+	}
+	if a.Hash(seed) == hashSetOf(1, 2).Hash(seed) {
+		t.Fatalf("Expected different sets to have different hashes")
+	}
+}
@@ -0,0 +1,226 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"hash/maphash"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+	"github.com/elias8/go-gather/internal/hashing"
+	"github.com/elias8/go-gather/tree"
+)
+
+// treeSet keeps its elements in a red-black tree, which gives O(log n)
+// lookups, insertions and removals.
+type treeSet[T any] struct {
+	tree    tree.Tree[T]
+	compare func(a, b T) int
+	hash    func(seed maphash.Seed, v T) uint64
+}
+
+// NewTreeSet returns an empty SortedSet that orders its elements using their
+// natural ordering, and hashes them using maphash.Comparable.
+func NewTreeSet[T cmp.Ordered]() SortedSet[T] {
+	return NewTreeSetFunc(cmp.Compare[T], base.Hash[T])
+}
+
+// NewTreeSetFunc returns an empty SortedSet that orders its elements using the
+// given compare function, which returns a negative number when a < b, a
+// positive number when a > b and zero when a == b. Two elements are considered
+// equal if compare returns zero. Elements are hashed using the given hash
+// function, which must return the same hash for elements that compare
+// reports equal. If hash is nil, every element hashes alike, which is
+// consistent with any compare function but makes the Hash of the set depend
+// on its size only, so pass a hash function if sets are used as map keys.
+func NewTreeSetFunc[T any](compare func(a, b T) int, hash func(seed maphash.Seed, v T) uint64) SortedSet[T] {
+	if hash == nil {
+		hash = sameHash[T]
+	}
+	return &treeSet[T]{tree: tree.NewRedBlackFunc(compare), compare: compare, hash: hash}
+}
+
+func (s *treeSet[T]) Size() int {
+	return s.tree.Size()
+}
+
+func (s *treeSet[T]) IsEmpty() bool {
+	return s.tree.IsEmpty()
+}
+
+func (s *treeSet[T]) Contains(element T) bool {
+	return s.tree.Contains(element)
+}
+
+func (s *treeSet[T]) Values() []T {
+	return s.tree.Values()
+}
+
+func (s *treeSet[T]) Clear() {
+	s.tree.Clear()
+}
+
+func (s *treeSet[T]) String() string {
+	str := "TreeSet(["
+	for i, e := range s.tree.Values() {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", e)
+	}
+	return str + "])"
+}
+
+func (s *treeSet[T]) Iterator() base.Iterator[T] {
+	return s.tree.Iterator()
+}
+
+func (s *treeSet[T]) All() iter.Seq[T] {
+	return s.tree.All()
+}
+
+func (s *treeSet[T]) Add(element T) bool {
+	if s.tree.Contains(element) {
+		return false
+	}
+	return s.tree.Insert(element)
+}
+
+func (s *treeSet[T]) Remove(element T) bool {
+	return s.tree.Delete(element)
+}
+
+func (s *treeSet[T]) ContainsAll(elements ...T) bool {
+	return containsAll[T](s, elements)
+}
+
+func (s *treeSet[T]) Union(other Set[T]) Set[T] {
+	return union[T](s, other, s.empty())
+}
+
+func (s *treeSet[T]) Intersection(other Set[T]) Set[T] {
+	return intersection[T](s, other, s.empty())
+}
+
+func (s *treeSet[T]) Difference(other Set[T]) Set[T] {
+	return difference[T](s, other, s.empty())
+}
+
+func (s *treeSet[T]) SymmetricDifference(other Set[T]) Set[T] {
+	return symmetricDifference[T](s, other, s.empty())
+}
+
+func (s *treeSet[T]) IsSubsetOf(other Set[T]) bool {
+	return isSubsetOf[T](s, other)
+}
+
+func (s *treeSet[T]) First() (*T, bool) {
+	return s.tree.Min()
+}
+
+func (s *treeSet[T]) Last() (*T, bool) {
+	return s.tree.Max()
+}
+
+func (s *treeSet[T]) Floor(element T) (*T, bool) {
+	return s.tree.Floor(element)
+}
+
+func (s *treeSet[T]) Ceiling(element T) (*T, bool) {
+	return s.tree.Ceiling(element)
+}
+
+func (s *treeSet[T]) Lower(element T) (*T, bool) {
+	return s.tree.Predecessor(element)
+}
+
+func (s *treeSet[T]) Higher(element T) (*T, bool) {
+	return s.tree.Successor(element)
+}
+
+func (s *treeSet[T]) HeadSet(to T) SortedSet[T] {
+	first, ok := s.tree.Min()
+	return s.copyRange(first, ok, &to)
+}
+
+func (s *treeSet[T]) TailSet(from T) SortedSet[T] {
+	first, ok := s.tree.Ceiling(from)
+	return s.copyRange(first, ok, nil)
+}
+
+func (s *treeSet[T]) SubSet(from, to T) SortedSet[T] {
+	first, ok := s.tree.Ceiling(from)
+	return s.copyRange(first, ok, &to)
+}
+
+// sameHash hashes every element alike. Unlike base.DeepHash, it returns the
+// same hash for elements that a compare function coarser than deep equality
+// reports equal.
+func sameHash[T any](maphash.Seed, T) uint64 {
+	return 0
+}
+
+func (s *treeSet[T]) empty() *treeSet[T] {
+	return &treeSet[T]{tree: tree.NewRedBlackFunc(s.compare), compare: s.compare, hash: s.hash}
+}
+
+// copyRange returns a new set containing the elements from first, inclusive,
+// to to, exclusive. If to is nil, the range extends to the last element.
+func (s *treeSet[T]) copyRange(first *T, ok bool, to *T) *treeSet[T] {
+	result := s.empty()
+	for e := first; ok && (to == nil || s.compare(*e, *to) < 0); e, ok = s.tree.Successor(*e) {
+		result.tree.Insert(*e)
+	}
+	return result
+}
+
+func (s *treeSet[T]) Equals(other base.Collection[T]) bool {
+	return equals[T](s, other)
+}
+
+func (s *treeSet[T]) Hash(seed maphash.Seed) uint64 {
+	return hashing.Unordered(seed, s.All(), s.hash)
+}
+
+func (s *treeSet[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(s.Values())
+}
+
+func (s *treeSet[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *treeSet[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(s.Values())
+}
+
+func (s *treeSet[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *treeSet[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+func (s *treeSet[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the set with the decoded values.
+func (s *treeSet[T]) replace(values []T) {
+	s.Clear()
+	for _, v := range values {
+		s.Add(v)
+	}
+}
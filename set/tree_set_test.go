@@ -0,0 +1,210 @@
+package set
+
+import (
+	"encoding/json"
+	"hash/maphash"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func treeSetOf(elements ...int) SortedSet[int] {
+	s := NewTreeSet[int]()
+	for _, e := range elements {
+		s.Add(e)
+	}
+	return s
+}
+
+func TestNewTreeSet(t *testing.T) {
+	s := NewTreeSet[int]()
+	if !s.IsEmpty() || s.Size() != 0 {
+		t.Fatalf("Expected NewTreeSet() to return an empty Set")
+	}
+	if _, ok := s.First(); ok {
+		t.Fatalf("Expected empty set to have no first element")
+	}
+	if _, ok := s.Last(); ok {
+		t.Fatalf("Expected empty set to have no last element")
+	}
+}
+
+func TestNewTreeSetFunc(t *testing.T) {
+	s := NewTreeSetFunc(
+		func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) },
+		func(seed maphash.Seed, s string) uint64 { return maphash.String(seed, strings.ToLower(s)) },
+	)
+	s.Add("b")
+	s.Add("A")
+	if s.Add("a") {
+		t.Fatalf("Expected a to be a duplicate of A")
+	}
+	if values := s.Values(); !slices.Equal(values, []string{"A", "b"}) {
+		t.Fatalf("Expected [A b], got %v", values)
+	}
+}
+
+func TestNewTreeSetFunc_NilHash(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	byID := func(a, b user) int { return a.id - b.id }
+	a, b := NewTreeSetFunc(byID, nil), NewTreeSetFunc(byID, nil)
+	a.Add(user{1, "ann"})
+	b.Add(user{1, "renamed"})
+	seed := maphash.MakeSeed()
+	if !a.Equals(b) || a.Hash(seed) != b.Hash(seed) {
+		t.Fatalf("Expected sets equal by compare to hash alike without a hash function")
+	}
+}
+
+func TestTreeSet_Order(t *testing.T) {
+	s := treeSetOf(5, 1, 4, 2, 3, 1)
+	if values := s.Values(); !slices.Equal(values, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("Expected [1 2 3 4 5], got %v", values)
+	}
+	if first, _ := s.First(); *first != 1 {
+		t.Fatalf("Expected first to be 1, got %d", *first)
+	}
+	if last, _ := s.Last(); *last != 5 {
+		t.Fatalf("Expected last to be 5, got %d", *last)
+	}
+	if s.String() != "TreeSet([1, 2, 3, 4, 5])" {
+		t.Fatalf("Expected TreeSet([1, 2, 3, 4, 5]), got %s", s.String())
+	}
+}
+
+func TestTreeSet_Navigation(t *testing.T) {
+	s := treeSetOf(10, 20, 30)
+	scenarios := []struct {
+		name     string
+		find     func(int) (*int, bool)
+		element  int
+		expected int
+		found    bool
+	}{
+		{name: "floor of present", find: s.Floor, element: 20, expected: 20, found: true},
+		{name: "floor of absent", find: s.Floor, element: 25, expected: 20, found: true},
+		{name: "floor below first", find: s.Floor, element: 5, found: false},
+		{name: "ceiling of present", find: s.Ceiling, element: 20, expected: 20, found: true},
+		{name: "ceiling of absent", find: s.Ceiling, element: 25, expected: 30, found: true},
+		{name: "ceiling above last", find: s.Ceiling, element: 35, found: false},
+		{name: "lower of present", find: s.Lower, element: 20, expected: 10, found: true},
+		{name: "lower of first", find: s.Lower, element: 10, found: false},
+		{name: "higher of present", find: s.Higher, element: 20, expected: 30, found: true},
+		{name: "higher of absent", find: s.Higher, element: 15, expected: 20, found: true},
+		{name: "higher of last", find: s.Higher, element: 30, found: false},
+	}
+
+	for _, sc := range scenarios {
+		t.Run(sc.name, func(t *testing.T) {
+			e, found := sc.find(sc.element)
+			if found != sc.found {
+				t.Fatalf("Expected found to be %v", sc.found)
+			}
+			if found && *e != sc.expected {
+				t.Fatalf("Expected %d, got %d", sc.expected, *e)
+			}
+		})
+	}
+}
+
+func TestTreeSet_NavigationReturnsCopies(t *testing.T) {
+	s := treeSetOf(2, 1, 3)
+	floor, _ := s.Floor(2)
+	s.Remove(2)
+	if *floor != 2 {
+		t.Fatalf("Expected Floor(2) to keep 2 after Remove(2), got %d", *floor)
+	}
+
+	first, _ := s.First()
+	*first = 100
+	if !s.Contains(1) || s.Contains(100) {
+		t.Fatalf("Expected modifying First to leave the set unchanged, got %v", s)
+	}
+}
+
+func TestTreeSet_Ranges(t *testing.T) {
+	s := treeSetOf(1, 2, 3, 4, 5)
+	if values := s.HeadSet(3).Values(); !slices.Equal(values, []int{1, 2}) {
+		t.Fatalf("Expected head set [1 2], got %v", values)
+	}
+	if values := s.TailSet(3).Values(); !slices.Equal(values, []int{3, 4, 5}) {
+		t.Fatalf("Expected tail set [3 4 5], got %v", values)
+	}
+	if values := s.SubSet(2, 4).Values(); !slices.Equal(values, []int{2, 3}) {
+		t.Fatalf("Expected sub set [2 3], got %v", values)
+	}
+	if values := s.SubSet(4, 2).Values(); len(values) != 0 {
+		t.Fatalf("Expected empty sub set for reversed bounds, got %v", values)
+	}
+	sub := s.SubSet(2, 4)
+	sub.Add(10)
+	if s.Contains(10) {
+		t.Fatalf("Expected sub set to be independent of the set")
+	}
+}
+
+func TestTreeSet_Remove(t *testing.T) {
+	s := treeSetOf(1, 2, 3)
+	if !s.Remove(2) || s.Remove(2) {
+		t.Fatalf("Expected 2 to be removed exactly once")
+	}
+	if values := s.Values(); !slices.Equal(values, []int{1, 3}) {
+		t.Fatalf("Expected [1 3], got %v", values)
+	}
+}
+
+func TestTreeSet_Operations(t *testing.T) {
+	a := treeSetOf(3, 1, 2)
+	b := treeSetOf(5, 3, 4)
+
+	if values := a.Union(b).Values(); !slices.Equal(values, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("Expected union [1 2 3 4 5], got %v", values)
+	}
+	if values := a.SymmetricDifference(b).Values(); !slices.Equal(values, []int{1, 2, 4, 5}) {
+		t.Fatalf("Expected symmetric difference [1 2 4 5], got %v", values)
+	}
+	if _, ok := a.Union(b).(SortedSet[int]); !ok {
+		t.Fatalf("Expected union of tree sets to be a SortedSet")
+	}
+	if !a.Intersection(b).IsSubsetOf(b) {
+		t.Fatalf("Expected intersection to be a subset of the other set")
+	}
+	if values := a.Difference(b).Values(); !slices.Equal(values, []int{1, 2}) {
+		t.Fatalf("Expected difference [1 2], got %v", values)
+	}
+}
+
+func TestTreeSet_Iterator(t *testing.T) {
+	s := treeSetOf(1, 2, 3, 4)
+	it := s.Iterator()
+	for it.HasNext() {
+		if e, _ := it.Next(); *e > 2 {
+			it.Remove()
+		}
+	}
+	if values := slices.Collect(s.All()); !slices.Equal(values, []int{1, 2}) {
+		t.Fatalf("Expected [1 2], got %v", values)
+	}
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Fatalf("Expected set to be empty after clearing")
+	}
+}
+
+func TestTreeSet_JSON(t *testing.T) {
+	decoded := NewTreeSet[int]()
+	if err := json.Unmarshal([]byte("[3, 1, 2, 1]"), decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+
+	data, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if string(data) != "[1,2,3]" {
+		t.Fatalf("Expected tree set to be encoded in order as [1,2,3], but got %s", data)
+	}
+}
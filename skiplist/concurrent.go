@@ -0,0 +1,408 @@
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// lazyNode is an element of a concurrentSkipList. A node is part of the skip
+// list once it is fully linked and until it is marked; in between, it may be
+// reachable at some levels only.
+type lazyNode[T any] struct {
+	value       atomic.Pointer[T]
+	next        []atomic.Pointer[lazyNode[T]]
+	mu          sync.Mutex
+	marked      atomic.Bool
+	fullyLinked atomic.Bool
+}
+
+// live reports whether the node is part of the skip list.
+func (n *lazyNode[T]) live() bool {
+	return n.fullyLinked.Load() && !n.marked.Load()
+}
+
+// concurrentSkipList is the lazy skip list of Herlihy, Lev, Luchangco and
+// Shavit. Readers never lock: they traverse the links and only look at the
+// flags of the node they end on. Writers lock the predecessors of the node
+// they link or unlink, validate that those are still unmarked and still point
+// where the traversal found them, and retry otherwise.
+type concurrentSkipList[T any] struct {
+	head    *lazyNode[T]
+	size    atomic.Int64
+	compare func(a, b T) int
+	mu      sync.Mutex
+	levels  levels
+}
+
+// NewConcurrent returns an empty SkipList that is safe for concurrent use and
+// orders its elements using their natural ordering.
+func NewConcurrent[T cmp.Ordered]() SkipList[T] {
+	return NewConcurrentFunc(cmp.Compare[T])
+}
+
+// NewConcurrentFunc returns an empty SkipList that is safe for concurrent use
+// and orders its elements using the given compare function.
+//
+// Contains, Search and the navigation methods never block, and Insert and
+// Delete only lock the few nodes around the element they add or remove, so
+// goroutines working on different parts of the skip list do not contend.
+// Rank and Select walk the bottom level and take O(n) time. Iterators, All,
+// Range and Values are weakly consistent: they see every element present for
+// the whole iteration, and may or may not see elements added or removed
+// meanwhile. Clear and decoding remove the elements one by one, so other
+// goroutines may observe intermediate states.
+func NewConcurrentFunc[T any](compare func(a, b T) int) SkipList[T] {
+	return NewConcurrentSeededFunc(compare, rand.Uint64())
+}
+
+// NewConcurrentSeededFunc returns an empty SkipList like NewConcurrentFunc
+// whose element heights are drawn from a random source seeded with seed.
+func NewConcurrentSeededFunc[T any](compare func(a, b T) int, seed uint64) SkipList[T] {
+	head := &lazyNode[T]{next: make([]atomic.Pointer[lazyNode[T]], maxLevel)}
+	head.fullyLinked.Store(true)
+	return &concurrentSkipList[T]{head: head, compare: compare, levels: newLevels(seed)}
+}
+
+// find fills preds and succs, for every level, with the last node whose value
+// is less than element and the node following it. It returns the highest
+// level at which a node equal to element was found, or -1.
+func (s *concurrentSkipList[T]) find(element T, preds, succs *[maxLevel]*lazyNode[T]) int {
+	found := -1
+	pred := s.head
+	for level := maxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && s.compare(*curr.value.Load(), element) < 0 {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+		if found == -1 && curr != nil && s.compare(*curr.value.Load(), element) == 0 {
+			found = level
+		}
+		preds[level] = pred
+		succs[level] = curr
+	}
+	return found
+}
+
+// lockPredecessors locks the distinct nodes of preds up to level top,
+// exclusive, from the bottom level upwards, so that all writers lock nodes in
+// descending order. It reports whether valid holds at every level. If it does,
+// the nodes stay locked and must be released with unlockPredecessors;
+// otherwise they are unlocked before returning.
+func lockPredecessors[T any](preds *[maxLevel]*lazyNode[T], top int, valid func(level int) bool) bool {
+	var previous *lazyNode[T]
+	ok := true
+	for level := 0; ok && level < top; level++ {
+		pred := preds[level]
+		if pred != previous {
+			pred.mu.Lock()
+			previous = pred
+		}
+		ok = !pred.marked.Load() && valid(level)
+		if !ok {
+			unlockPredecessors(preds, level+1)
+		}
+	}
+	return ok
+}
+
+// unlockPredecessors unlocks the distinct nodes of preds up to level top,
+// exclusive.
+func unlockPredecessors[T any](preds *[maxLevel]*lazyNode[T], top int) {
+	var previous *lazyNode[T]
+	for level := 0; level < top; level++ {
+		if pred := preds[level]; pred != previous {
+			pred.mu.Unlock()
+			previous = pred
+		}
+	}
+}
+
+func (s *concurrentSkipList[T]) Insert(element T) bool {
+	s.mu.Lock()
+	top := s.levels.next()
+	s.mu.Unlock()
+	var preds, succs [maxLevel]*lazyNode[T]
+	for {
+		if found := s.find(element, &preds, &succs); found != -1 {
+			n := succs[found]
+			if n.marked.Load() {
+				// The node is being removed; wait for it to be unlinked.
+				runtime.Gosched()
+				continue
+			}
+			for !n.fullyLinked.Load() {
+				runtime.Gosched()
+			}
+			n.value.Store(&element)
+			return false
+		}
+		valid := lockPredecessors(&preds, top, func(level int) bool {
+			succ := succs[level]
+			return (succ == nil || !succ.marked.Load()) && preds[level].next[level].Load() == succ
+		})
+		if !valid {
+			continue
+		}
+		n := &lazyNode[T]{next: make([]atomic.Pointer[lazyNode[T]], top)}
+		n.value.Store(&element)
+		for level := 0; level < top; level++ {
+			n.next[level].Store(succs[level])
+		}
+		for level := 0; level < top; level++ {
+			preds[level].next[level].Store(n)
+		}
+		n.fullyLinked.Store(true)
+		unlockPredecessors(&preds, top)
+		s.size.Add(1)
+		return true
+	}
+}
+
+func (s *concurrentSkipList[T]) Delete(element T) bool {
+	var preds, succs [maxLevel]*lazyNode[T]
+	var victim *lazyNode[T]
+	for {
+		found := s.find(element, &preds, &succs)
+		if victim == nil {
+			if found == -1 {
+				return false
+			}
+			n := succs[found]
+			if !n.fullyLinked.Load() || n.marked.Load() || len(n.next)-1 != found {
+				return false
+			}
+			n.mu.Lock()
+			if n.marked.Load() {
+				n.mu.Unlock()
+				return false
+			}
+			n.marked.Store(true)
+			victim = n
+		}
+		top := len(victim.next)
+		valid := lockPredecessors(&preds, top, func(level int) bool {
+			return preds[level].next[level].Load() == victim
+		})
+		if !valid {
+			continue
+		}
+		for level := top - 1; level >= 0; level-- {
+			preds[level].next[level].Store(victim.next[level].Load())
+		}
+		victim.mu.Unlock()
+		unlockPredecessors(&preds, top)
+		s.size.Add(-1)
+		return true
+	}
+}
+
+func (s *concurrentSkipList[T]) Search(element T) (*T, bool) {
+	var preds, succs [maxLevel]*lazyNode[T]
+	found := s.find(element, &preds, &succs)
+	if found == -1 || !succs[found].live() {
+		return nil, false
+	}
+	return copyOf(succs[found].value.Load(), true), true
+}
+
+func (s *concurrentSkipList[T]) Contains(element T) bool {
+	_, found := s.Search(element)
+	return found
+}
+
+// last returns the value of the last element of the skip list for which
+// before returns true, where before must hold for a prefix of the elements.
+func (s *concurrentSkipList[T]) last(before func(value T) bool) (*T, bool) {
+	for {
+		pred := s.head
+		for level := maxLevel - 1; level >= 0; level-- {
+			for curr := pred.next[level].Load(); curr != nil && before(*curr.value.Load()); curr = pred.next[level].Load() {
+				pred = curr
+			}
+		}
+		if pred == s.head {
+			return nil, false
+		}
+		if pred.live() {
+			return copyOf(pred.value.Load(), true), true
+		}
+		// The node is being added or removed; wait for it to settle.
+		runtime.Gosched()
+	}
+}
+
+// first returns the first live node of the skip list whose value is greater
+// than element, or greater than or equal to it if inclusive is true.
+func (s *concurrentSkipList[T]) first(element T, inclusive bool) *lazyNode[T] {
+	var preds, succs [maxLevel]*lazyNode[T]
+	s.find(element, &preds, &succs)
+	n := succs[0]
+	for n != nil && (!n.live() || (!inclusive && s.compare(*n.value.Load(), element) == 0)) {
+		n = n.next[0].Load()
+	}
+	return n
+}
+
+func (s *concurrentSkipList[T]) Min() (*T, bool) {
+	for n := s.head.next[0].Load(); n != nil; n = n.next[0].Load() {
+		if n.live() {
+			return copyOf(n.value.Load(), true), true
+		}
+	}
+	return nil, false
+}
+
+func (s *concurrentSkipList[T]) Max() (*T, bool) {
+	return s.last(func(T) bool { return true })
+}
+
+func (s *concurrentSkipList[T]) Floor(element T) (*T, bool) {
+	return s.last(func(value T) bool { return s.compare(value, element) <= 0 })
+}
+
+func (s *concurrentSkipList[T]) Ceiling(element T) (*T, bool) {
+	return lazyValueOf(s.first(element, true))
+}
+
+func (s *concurrentSkipList[T]) Predecessor(element T) (*T, bool) {
+	return s.last(func(value T) bool { return s.compare(value, element) < 0 })
+}
+
+func (s *concurrentSkipList[T]) Successor(element T) (*T, bool) {
+	return lazyValueOf(s.first(element, false))
+}
+
+func lazyValueOf[T any](n *lazyNode[T]) (*T, bool) {
+	if n == nil {
+		return nil, false
+	}
+	return copyOf(n.value.Load(), true), true
+}
+
+func (s *concurrentSkipList[T]) Rank(element T) int {
+	rank := 0
+	for v := range s.All() {
+		if s.compare(v, element) >= 0 {
+			break
+		}
+		rank++
+	}
+	return rank
+}
+
+func (s *concurrentSkipList[T]) Select(rank int) (*T, bool) {
+	if rank < 0 {
+		return nil, false
+	}
+	for v := range s.All() {
+		if rank == 0 {
+			return &v, true
+		}
+		rank--
+	}
+	return nil, false
+}
+
+func (s *concurrentSkipList[T]) Range(from, to T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.first(from, true); n != nil; n = n.next[0].Load() {
+			value := *n.value.Load()
+			if s.compare(value, to) >= 0 {
+				return
+			}
+			if n.live() && !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *concurrentSkipList[T]) Clear() {
+	for v := range s.All() {
+		s.Delete(v)
+	}
+}
+
+func (s *concurrentSkipList[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+func (s *concurrentSkipList[T]) Size() int {
+	return int(s.size.Load())
+}
+
+func (s *concurrentSkipList[T]) Values() []T {
+	values := make([]T, 0, s.Size())
+	for v := range s.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (s *concurrentSkipList[T]) String() string {
+	return format("ConcurrentSkipList", s.Values())
+}
+
+func (s *concurrentSkipList[T]) Iterator() base.Iterator[T] {
+	return &iterator[T]{list: s}
+}
+
+func (s *concurrentSkipList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.head.next[0].Load(); n != nil; n = n.next[0].Load() {
+			if n.live() && !yield(*n.value.Load()) {
+				return
+			}
+		}
+	}
+}
+
+func (s *concurrentSkipList[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(s.Values())
+}
+
+func (s *concurrentSkipList[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *concurrentSkipList[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(s.Values())
+}
+
+func (s *concurrentSkipList[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *concurrentSkipList[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+func (s *concurrentSkipList[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the skip list with the decoded values.
+func (s *concurrentSkipList[T]) replace(values []T) {
+	s.Clear()
+	for _, v := range values {
+		s.Insert(v)
+	}
+}
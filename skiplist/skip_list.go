@@ -0,0 +1,303 @@
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// node is an element of a skipList. next[i] is the following node at level i,
+// and span[i] is the number of elements that link skips over, counting the
+// node it leads to, or up to the end of the list if next[i] is nil.
+type node[T any] struct {
+	value T
+	next  []*node[T]
+	span  []int
+}
+
+// skipList is an indexable skip list: the spans of its links let Rank and
+// Select run in expected O(log n) time as well.
+type skipList[T any] struct {
+	head    *node[T]
+	level   int
+	size    int
+	compare func(a, b T) int
+	levels  levels
+}
+
+// New returns an empty SkipList that orders its elements using their natural
+// ordering.
+func New[T cmp.Ordered]() SkipList[T] {
+	return NewFunc(cmp.Compare[T])
+}
+
+// NewFunc returns an empty SkipList that orders its elements using the given
+// compare function, which returns a negative number when a < b, a positive
+// number when a > b and zero when a == b.
+func NewFunc[T any](compare func(a, b T) int) SkipList[T] {
+	return NewSeededFunc(compare, rand.Uint64())
+}
+
+// NewSeeded returns an empty SkipList like New whose element heights are drawn
+// from a random source seeded with seed, so that the same sequence of
+// operations always builds the same skip list.
+func NewSeeded[T cmp.Ordered](seed uint64) SkipList[T] {
+	return NewSeededFunc(cmp.Compare[T], seed)
+}
+
+// NewSeededFunc returns an empty SkipList like NewFunc whose element heights
+// are drawn from a random source seeded with seed.
+func NewSeededFunc[T any](compare func(a, b T) int, seed uint64) SkipList[T] {
+	return &skipList[T]{head: newHead[T](), level: 1, compare: compare, levels: newLevels(seed)}
+}
+
+func newHead[T any]() *node[T] {
+	return &node[T]{next: make([]*node[T], maxLevel), span: make([]int, maxLevel)}
+}
+
+// find returns, for every level, the last node whose value is less than
+// element, or less than or equal to it if inclusive is true, together with
+// the number of elements up to and including that node.
+func (s *skipList[T]) find(element T, inclusive bool) (update [maxLevel]*node[T], rank [maxLevel]int) {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for next := x.next[i]; next != nil; next = x.next[i] {
+			if c := s.compare(next.value, element); c > 0 || (c == 0 && !inclusive) {
+				break
+			}
+			rank[i] += x.span[i]
+			x = next
+		}
+		update[i] = x
+	}
+	return update, rank
+}
+
+func (s *skipList[T]) Insert(element T) bool {
+	update, rank := s.find(element, false)
+	if n := update[0].next[0]; n != nil && s.compare(n.value, element) == 0 {
+		n.value = element
+		return false
+	}
+	level := s.levels.next()
+	for i := s.level; i < level; i++ {
+		update[i] = s.head
+		rank[i] = 0
+		s.head.span[i] = s.size
+	}
+	s.level = max(s.level, level)
+	n := &node[T]{value: element, next: make([]*node[T], level), span: make([]int, level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	for i := level; i < s.level; i++ {
+		update[i].span[i]++
+	}
+	s.size++
+	return true
+}
+
+func (s *skipList[T]) Delete(element T) bool {
+	update, _ := s.find(element, false)
+	n := update[0].next[0]
+	if n == nil || s.compare(n.value, element) != 0 {
+		return false
+	}
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i] == n {
+			update[i].span[i] += n.span[i] - 1
+			update[i].next[i] = n.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+	return true
+}
+
+func (s *skipList[T]) Search(element T) (*T, bool) {
+	update, _ := s.find(element, false)
+	if n := update[0].next[0]; n != nil && s.compare(n.value, element) == 0 {
+		return &n.value, true
+	}
+	return nil, false
+}
+
+func (s *skipList[T]) Contains(element T) bool {
+	_, found := s.Search(element)
+	return found
+}
+
+func (s *skipList[T]) Min() (*T, bool) {
+	return valueOf(s.head.next[0])
+}
+
+func (s *skipList[T]) Max() (*T, bool) {
+	if s.size == 0 {
+		return nil, false
+	}
+	return s.Select(s.size - 1)
+}
+
+func (s *skipList[T]) Floor(element T) (*T, bool) {
+	update, _ := s.find(element, true)
+	return valueOf(s.real(update[0]))
+}
+
+func (s *skipList[T]) Ceiling(element T) (*T, bool) {
+	update, _ := s.find(element, false)
+	return valueOf(update[0].next[0])
+}
+
+func (s *skipList[T]) Predecessor(element T) (*T, bool) {
+	update, _ := s.find(element, false)
+	return valueOf(s.real(update[0]))
+}
+
+func (s *skipList[T]) Successor(element T) (*T, bool) {
+	update, _ := s.find(element, true)
+	return valueOf(update[0].next[0])
+}
+
+func (s *skipList[T]) Rank(element T) int {
+	_, rank := s.find(element, false)
+	return rank[0]
+}
+
+func (s *skipList[T]) Select(rank int) (*T, bool) {
+	if rank < 0 || rank >= s.size {
+		return nil, false
+	}
+	x := s.head
+	traversed := 0
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= rank+1 {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+		if traversed == rank+1 {
+			break
+		}
+	}
+	return &x.value, true
+}
+
+func (s *skipList[T]) Range(from, to T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		update, _ := s.find(from, false)
+		for n := update[0].next[0]; n != nil && s.compare(n.value, to) < 0; n = n.next[0] {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// real returns n, or nil if n is the head of the list.
+func (s *skipList[T]) real(n *node[T]) *node[T] {
+	if n == s.head {
+		return nil
+	}
+	return n
+}
+
+func valueOf[T any](n *node[T]) (*T, bool) {
+	if n == nil {
+		return nil, false
+	}
+	return &n.value, true
+}
+
+func (s *skipList[T]) Clear() {
+	s.head = newHead[T]()
+	s.level = 1
+	s.size = 0
+}
+
+func (s *skipList[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+func (s *skipList[T]) Size() int {
+	return s.size
+}
+
+func (s *skipList[T]) Values() []T {
+	values := make([]T, 0, s.size)
+	for v := range s.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (s *skipList[T]) String() string {
+	return format("SkipList", s.Values())
+}
+
+func (s *skipList[T]) Iterator() base.Iterator[T] {
+	return &iterator[T]{list: s}
+}
+
+func (s *skipList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *skipList[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(s.Values())
+}
+
+func (s *skipList[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *skipList[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(s.Values())
+}
+
+func (s *skipList[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *skipList[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+func (s *skipList[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the skip list with the decoded values.
+func (s *skipList[T]) replace(values []T) {
+	s.Clear()
+	for _, v := range values {
+		s.Insert(v)
+	}
+}
@@ -0,0 +1,149 @@
+// Package skiplist provides skip lists: ordered collections of distinct
+// elements that find, insert and remove elements in expected O(log n) time by
+// keeping a hierarchy of linked lists, each skipping over more elements than
+// the one below it. The height of every element is chosen at random; a skip
+// list created with a seed makes the same choices every time, which makes
+// tests reproducible.
+package skiplist
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+	"math/rand/v2"
+
+	"github.com/elias8/go-gather/base"
+)
+
+// maxLevel is the number of levels of a skip list. With a promotion
+// probability of 1/4, it allows for far more elements than fit in memory
+// before lookups degrade.
+const maxLevel = 32
+
+// SkipList is an ordered collection of distinct elements, ordered by the
+// compare function it was created with. Two elements are considered equal if
+// compare returns zero. All, Values and Iterator visit the elements in
+// ascending order.
+type SkipList[T any] interface {
+	base.Collection[T]
+
+	// Insert adds the element to the skip list. If the skip list already
+	// holds an equal element, it is replaced by element and Insert returns
+	// false.
+	Insert(element T) bool
+
+	// Delete removes the element equal to element from the skip list. Returns
+	// false if the skip list does not contain such an element.
+	Delete(element T) bool
+
+	// Search returns the element of the skip list equal to element. Returns
+	// nil and false if the skip list does not contain such an element.
+	Search(element T) (*T, bool)
+
+	// Min returns the smallest element of the skip list. Returns nil and false
+	// if the skip list is empty.
+	Min() (*T, bool)
+
+	// Max returns the largest element of the skip list. Returns nil and false
+	// if the skip list is empty.
+	Max() (*T, bool)
+
+	// Floor returns the largest element less than or equal to element.
+	// Returns nil and false if there is no such element.
+	Floor(element T) (*T, bool)
+
+	// Ceiling returns the smallest element greater than or equal to element.
+	// Returns nil and false if there is no such element.
+	Ceiling(element T) (*T, bool)
+
+	// Predecessor returns the largest element strictly less than element.
+	// Returns nil and false if there is no such element.
+	Predecessor(element T) (*T, bool)
+
+	// Successor returns the smallest element strictly greater than element.
+	// Returns nil and false if there is no such element.
+	Successor(element T) (*T, bool)
+
+	// Rank returns the number of elements of the skip list that are strictly
+	// less than element.
+	Rank(element T) int
+
+	// Select returns the element with the given rank, that is the element
+	// that has exactly rank smaller elements in the skip list. Returns nil and
+	// false if the rank is out of range (rank < 0 || rank >= Size()).
+	Select(rank int) (*T, bool)
+
+	// Range returns an iterator over the elements ranging from from,
+	// inclusive, to to, exclusive, in ascending order.
+	Range(from, to T) iter.Seq[T]
+}
+
+// levels picks the heights of the elements of a skip list. Every element is
+// promoted to the next level with probability 1/4.
+type levels struct {
+	random *rand.Rand
+}
+
+func newLevels(seed uint64) levels {
+	return levels{random: rand.New(rand.NewPCG(seed, seed))}
+}
+
+// next returns the height of a new element, between 1 and maxLevel.
+func (l levels) next() int {
+	return min(maxLevel, 1+bits.TrailingZeros64(l.random.Uint64())/2)
+}
+
+// iterator iterates over the elements of a skip list in ascending order. It
+// finds every element as the successor of the previous one, so it remains
+// valid when elements are removed through it or by other goroutines.
+type iterator[T any] struct {
+	list    SkipList[T]
+	next    *T
+	last    *T
+	started bool
+}
+
+func (it *iterator[T]) HasNext() bool {
+	if !it.started {
+		it.next = copyOf(it.list.Min())
+		it.started = true
+	}
+	return it.next != nil
+}
+
+func (it *iterator[T]) Next() (*T, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	it.last = it.next
+	it.next = copyOf(it.list.Successor(*it.last))
+	return it.last, true
+}
+
+func (it *iterator[T]) Remove() bool {
+	if it.last == nil {
+		return false
+	}
+	removed := it.list.Delete(*it.last)
+	it.last = nil
+	return removed
+}
+
+func copyOf[T any](p *T, ok bool) *T {
+	if !ok {
+		return nil
+	}
+	value := *p
+	return &value
+}
+
+func format[T any](name string, values []T) string {
+	str := name + "(["
+	for i, v := range values {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", v)
+	}
+	return str + "])"
+}
@@ -0,0 +1,344 @@
+package skiplist
+
+import (
+	"cmp"
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+)
+
+var constructors = []struct {
+	name string
+	new  func() SkipList[int]
+}{
+	{name: "skip list", new: func() SkipList[int] { return NewSeeded[int](1) }},
+	{name: "concurrent skip list", new: func() SkipList[int] { return NewConcurrentSeededFunc(cmp.Compare[int], 1) }},
+}
+
+func skipListOf(newSkipList func() SkipList[int], elements ...int) SkipList[int] {
+	s := newSkipList()
+	for _, e := range elements {
+		s.Insert(e)
+	}
+	return s
+}
+
+func TestSkipList_InsertDelete(t *testing.T) {
+	for _, c := range constructors {
+		t.Run(c.name, func(t *testing.T) {
+			list := c.new()
+			r := rand.New(rand.NewSource(1))
+			present := map[int]bool{}
+			for range 2000 {
+				v := r.Intn(300)
+				if r.Intn(3) == 0 {
+					if list.Delete(v) != present[v] {
+						t.Fatalf("Expected Delete(%v) to return %v", v, present[v])
+					}
+					delete(present, v)
+				} else {
+					if list.Insert(v) == present[v] {
+						t.Fatalf("Expected Insert(%v) to return %v", v, !present[v])
+					}
+					present[v] = true
+				}
+			}
+
+			var expected []int
+			for v := range present {
+				expected = append(expected, v)
+			}
+			slices.Sort(expected)
+			if list.Size() != len(expected) {
+				t.Fatalf("Expected skip list to have %v elements, but got %v", len(expected), list.Size())
+			}
+			if values := list.Values(); !slices.Equal(values, expected) {
+				t.Fatalf("Expected values %v, but got %v", expected, values)
+			}
+			for i, v := range expected {
+				if !list.Contains(v) {
+					t.Fatalf("Expected skip list to contain %v", v)
+				}
+				if rank := list.Rank(v); rank != i {
+					t.Fatalf("Expected Rank(%v) to be %v, but got %v", v, i, rank)
+				}
+				if s, _ := list.Select(i); *s != v {
+					t.Fatalf("Expected Select(%v) to be %v, but got %v", i, v, *s)
+				}
+			}
+		})
+	}
+}
+
+func TestSkipList_Insert_Replace(t *testing.T) {
+	type entry struct{ key, value int }
+	byKey := func(a, b entry) int { return a.key - b.key }
+	for _, list := range []SkipList[entry]{NewFunc(byKey), NewConcurrentFunc(byKey)} {
+		list.Insert(entry{1, 1})
+		if list.Insert(entry{1, 2}) {
+			t.Fatalf("Expected Insert of an equal element to return false")
+		}
+		if e, _ := list.Search(entry{key: 1}); e.value != 2 {
+			t.Fatalf("Expected Insert to replace the equal element, but got %v", *e)
+		}
+	}
+}
+
+func TestSkipList_Queries(t *testing.T) {
+	type query struct {
+		name     string
+		query    func(list SkipList[int]) (*int, bool)
+		expected int
+		found    bool
+	}
+	scenarios := []query{
+		{name: "Min", query: func(s SkipList[int]) (*int, bool) { return s.Min() }, expected: 10, found: true},
+		{name: "Max", query: func(s SkipList[int]) (*int, bool) { return s.Max() }, expected: 50, found: true},
+		{name: "Search present", query: func(s SkipList[int]) (*int, bool) { return s.Search(30) }, expected: 30, found: true},
+		{name: "Search absent", query: func(s SkipList[int]) (*int, bool) { return s.Search(35) }},
+		{name: "Floor present", query: func(s SkipList[int]) (*int, bool) { return s.Floor(30) }, expected: 30, found: true},
+		{name: "Floor absent", query: func(s SkipList[int]) (*int, bool) { return s.Floor(35) }, expected: 30, found: true},
+		{name: "Floor below min", query: func(s SkipList[int]) (*int, bool) { return s.Floor(5) }},
+		{name: "Ceiling present", query: func(s SkipList[int]) (*int, bool) { return s.Ceiling(30) }, expected: 30, found: true},
+		{name: "Ceiling absent", query: func(s SkipList[int]) (*int, bool) { return s.Ceiling(35) }, expected: 40, found: true},
+		{name: "Ceiling above max", query: func(s SkipList[int]) (*int, bool) { return s.Ceiling(55) }},
+		{name: "Predecessor present", query: func(s SkipList[int]) (*int, bool) { return s.Predecessor(30) }, expected: 20, found: true},
+		{name: "Predecessor of min", query: func(s SkipList[int]) (*int, bool) { return s.Predecessor(10) }},
+		{name: "Successor present", query: func(s SkipList[int]) (*int, bool) { return s.Successor(30) }, expected: 40, found: true},
+		{name: "Successor absent", query: func(s SkipList[int]) (*int, bool) { return s.Successor(31) }, expected: 40, found: true},
+		{name: "Successor of max", query: func(s SkipList[int]) (*int, bool) { return s.Successor(50) }},
+		{name: "Select first", query: func(s SkipList[int]) (*int, bool) { return s.Select(0) }, expected: 10, found: true},
+		{name: "Select last", query: func(s SkipList[int]) (*int, bool) { return s.Select(4) }, expected: 50, found: true},
+		{name: "Select out of range", query: func(s SkipList[int]) (*int, bool) { return s.Select(5) }},
+		{name: "Select negative", query: func(s SkipList[int]) (*int, bool) { return s.Select(-1) }},
+	}
+
+	for _, c := range constructors {
+		list := skipListOf(c.new, 30, 10, 50, 20, 40)
+		for _, s := range scenarios {
+			t.Run(c.name+" "+s.name, func(t *testing.T) {
+				v, found := s.query(list)
+				if found != s.found {
+					t.Fatalf("Expected found to be %v, but got %v", s.found, found)
+				}
+				if found && *v != s.expected {
+					t.Fatalf("Expected %v, but got %v", s.expected, *v)
+				}
+			})
+		}
+	}
+}
+
+func TestSkipList_Queries_Empty(t *testing.T) {
+	for _, c := range constructors {
+		list := c.new()
+		if _, found := list.Min(); found {
+			t.Fatalf("%v: expected Min of an empty skip list to fail", c.name)
+		}
+		if _, found := list.Max(); found {
+			t.Fatalf("%v: expected Max of an empty skip list to fail", c.name)
+		}
+		if _, found := list.Floor(1); found {
+			t.Fatalf("%v: expected Floor of an empty skip list to fail", c.name)
+		}
+		if rank := list.Rank(1); rank != 0 {
+			t.Fatalf("%v: expected Rank in an empty skip list to be 0, but got %v", c.name, rank)
+		}
+	}
+}
+
+func TestSkipList_Rank(t *testing.T) {
+	for _, c := range constructors {
+		list := skipListOf(c.new, 30, 10, 50, 20, 40)
+		for element, expected := range map[int]int{5: 0, 10: 0, 25: 2, 30: 2, 50: 4, 60: 5} {
+			if rank := list.Rank(element); rank != expected {
+				t.Fatalf("%v: expected Rank(%v) to be %v, but got %v", c.name, element, expected, rank)
+			}
+		}
+	}
+}
+
+func TestSkipList_Range(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		from, to int
+		expected []int
+	}{
+		{name: "bounds present", from: 20, to: 40, expected: []int{20, 30}},
+		{name: "bounds absent", from: 15, to: 45, expected: []int{20, 30, 40}},
+		{name: "whole list", from: 0, to: 100, expected: []int{10, 20, 30, 40, 50}},
+		{name: "empty range", from: 30, to: 30},
+		{name: "reversed range", from: 40, to: 20},
+		{name: "beyond max", from: 60, to: 70},
+	}
+
+	for _, c := range constructors {
+		list := skipListOf(c.new, 30, 10, 50, 20, 40)
+		for _, s := range scenarios {
+			t.Run(c.name+" "+s.name, func(t *testing.T) {
+				if values := slices.Collect(list.Range(s.from, s.to)); !slices.Equal(values, s.expected) {
+					t.Fatalf("Expected %v, but got %v", s.expected, values)
+				}
+			})
+		}
+	}
+}
+
+func TestSkipList_Seeded(t *testing.T) {
+	// The heights of the elements only show in the shape of the skip list,
+	// so compare the levels of the nodes built from the same seed.
+	shape := func(seed uint64) []int {
+		list := NewSeeded[int](seed).(*skipList[int])
+		for i := range 100 {
+			list.Insert(i)
+		}
+		var heights []int
+		for n := list.head.next[0]; n != nil; n = n.next[0] {
+			heights = append(heights, len(n.next))
+		}
+		return heights
+	}
+
+	if first, second := shape(7), shape(7); !slices.Equal(first, second) {
+		t.Fatalf("Expected skip lists with the same seed to have the same shape, but got %v and %v", first, second)
+	}
+	if first, second := shape(7), shape(8); slices.Equal(first, second) {
+		t.Fatalf("Expected skip lists with different seeds to have different shapes")
+	}
+}
+
+func TestSkipList_Iterator(t *testing.T) {
+	for _, c := range constructors {
+		t.Run(c.name, func(t *testing.T) {
+			var elements []int
+			for i := range 100 {
+				elements = append(elements, i)
+			}
+			list := skipListOf(c.new, elements...)
+
+			var visited []int
+			it := list.Iterator()
+			if it.Remove() {
+				t.Fatalf("Expected Remove to fail before Next is called")
+			}
+			for it.HasNext() {
+				v, _ := it.Next()
+				visited = append(visited, *v)
+				if *v%3 != 0 && !it.Remove() {
+					t.Fatalf("Expected Remove to succeed")
+				}
+			}
+
+			if !slices.Equal(visited, elements) {
+				t.Fatalf("Expected iterator to visit every element once, but got %v", visited)
+			}
+			for i, v := range list.Values() {
+				if v != 3*i {
+					t.Fatalf("Expected only multiples of 3 to remain, but got %v", list)
+				}
+			}
+		})
+	}
+}
+
+func TestSkipList_Clear(t *testing.T) {
+	for _, c := range constructors {
+		list := skipListOf(c.new, 3, 1, 2)
+		list.Clear()
+		if !list.IsEmpty() || len(list.Values()) != 0 {
+			t.Fatalf("%v: expected skip list to be empty after Clear, but got %v", c.name, list)
+		}
+		list.Insert(4)
+		if values := list.Values(); !slices.Equal(values, []int{4}) {
+			t.Fatalf("%v: expected [4] after Clear and Insert, but got %v", c.name, values)
+		}
+	}
+}
+
+func TestSkipList_String(t *testing.T) {
+	if s := skipListOf(constructors[0].new, 2, 1).String(); s != "SkipList([1, 2])" {
+		t.Fatalf("Expected SkipList([1, 2]), but got %v", s)
+	}
+	if s := skipListOf(constructors[1].new).String(); s != "ConcurrentSkipList([])" {
+		t.Fatalf("Expected ConcurrentSkipList([]), but got %v", s)
+	}
+}
+
+func TestSkipList_JSON(t *testing.T) {
+	for _, c := range constructors {
+		list := c.new()
+		if err := json.Unmarshal([]byte("[3, 1, 2, 1]"), list); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		data, err := json.Marshal(list)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if string(data) != "[1,2,3]" {
+			t.Fatalf("%v: expected [1,2,3], but got %s", c.name, data)
+		}
+	}
+}
+
+func TestConcurrentSkipList_Parallel(t *testing.T) {
+	list := NewConcurrent[int]()
+	const goroutines, perGoroutine = 8, 500
+
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perGoroutine {
+				v := i*goroutines + g
+				list.Insert(v)
+				if v%2 == 1 && !list.Delete(v) {
+					t.Errorf("Expected Delete(%v) to succeed", v)
+				}
+				list.Contains(v)
+				list.Floor(v)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if list.Size() != goroutines*perGoroutine/2 {
+		t.Fatalf("Expected %v elements, but got %v", goroutines*perGoroutine/2, list.Size())
+	}
+	for i, v := range list.Values() {
+		if v != 2*i {
+			t.Fatalf("Expected only even numbers to remain, but got %v at %v", v, i)
+		}
+	}
+}
+
+func TestConcurrentSkipList_ParallelSameKeys(t *testing.T) {
+	list := NewConcurrent[int]()
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := rand.New(rand.NewSource(1))
+			for range 2000 {
+				v := r.Intn(50)
+				if r.Intn(2) == 0 {
+					list.Insert(v)
+				} else {
+					list.Delete(v)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	values := list.Values()
+	if len(values) != list.Size() {
+		t.Fatalf("Expected Size %v to match the %v elements", list.Size(), len(values))
+	}
+	if !slices.IsSorted(values) || len(slices.Compact(slices.Clone(values))) != len(values) {
+		t.Fatalf("Expected distinct sorted elements, but got %v", values)
+	}
+}
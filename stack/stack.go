@@ -2,7 +2,12 @@ package stack
 
 import (
 	"fmt"
+	"hash/maphash"
+	"iter"
+	"slices"
+
 	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
 	"github.com/elias8/go-gather/list"
 )
 
@@ -18,38 +23,74 @@ type Stack[T any] interface {
 
 	// Peek returns the top element of the stack without removing it.
 	Peek() (*T, bool)
+
+	// Backward returns an iterator over the index-element pairs of the stack,
+	// from the top element to the bottom.
+	Backward() iter.Seq2[int, T]
+
+	// Equals returns true if other is a Stack of the same size whose elements
+	// are equal, from the bottom to the top, to the elements of this stack, as
+	// determined by the equality of this stack.
+	Equals(other base.Collection[T]) bool
+
+	// Hash returns a hash of the elements of the stack, from the bottom to the
+	// top, computed with hash/maphash and the given seed from the hashes of
+	// the elements. Stacks that are Equals and hash their elements alike, such
+	// as stacks created with the same constructor, have the same hash for the
+	// same seed.
+	Hash(seed maphash.Seed) uint64
 }
 
 type stack[T any] struct {
 	linkedList list.LinkedList[T]
+	equal      func(a, b T) bool
 }
 
+// New returns an empty Stack. Elements are compared using reflect.DeepEqual
+// and hashed using base.DeepHash.
 func New[T any]() Stack[T] {
-	return &stack[T]{linkedList: list.NewLinkedList[T]()}
+	return NewFunc(base.DeepEqual[T], base.DeepHash[T])
+}
+
+// NewFunc returns an empty Stack that compares elements using the given equal
+// function and hashes them using the given hash function, which must return
+// the same hash for elements that equal reports equal. If equal is nil,
+// reflect.DeepEqual is used, and if hash is nil, base.DeepHash is used.
+func NewFunc[T any](equal func(a, b T) bool, hash func(seed maphash.Seed, v T) uint64) Stack[T] {
+	if equal == nil {
+		equal = base.DeepEqual[T]
+	}
+	return &stack[T]{linkedList: list.NewLinkedListFunc(equal, hash), equal: equal}
 }
 
-func (s stack[T]) Size() int {
+// NewComparable returns an empty Stack that compares elements using the ==
+// operator and hashes them using maphash.Comparable.
+func NewComparable[T comparable]() Stack[T] {
+	return NewFunc(base.Equal[T], base.Hash[T])
+}
+
+func (s *stack[T]) Size() int {
 	return s.linkedList.Size()
 }
 
-func (s stack[T]) IsEmpty() bool {
+func (s *stack[T]) IsEmpty() bool {
 	return s.linkedList.IsEmpty()
 
 }
 
-func (s stack[T]) Contains(element T) bool {
+func (s *stack[T]) Contains(element T) bool {
 	return s.linkedList.Contains(element)
 }
 
-func (s stack[T]) Values() []T {
+func (s *stack[T]) Values() []T {
 	return s.linkedList.Values()
 }
 
-func (s stack[T]) Clear() {
+func (s *stack[T]) Clear() {
 	s.linkedList.Clear()
 }
 
-func (s stack[T]) String() string {
+func (s *stack[T]) String() string {
 	str := "Stack(["
 	for i, v := range s.linkedList.Values() {
 		str += fmt.Sprintf("%v", v)
@@ -60,14 +101,78 @@ func (s stack[T]) String() string {
 	return str + "])"
 }
 
-func (s stack[T]) Push(element T) {
+func (s *stack[T]) Push(element T) {
 	s.linkedList.Add(element)
 }
 
-func (s stack[T]) Pop() (*T, bool) {
+func (s *stack[T]) Pop() (*T, bool) {
 	return s.linkedList.RemoveLast()
 }
 
-func (s stack[T]) Peek() (*T, bool) {
+func (s *stack[T]) Peek() (*T, bool) {
 	return s.linkedList.GetLast()
 }
+
+func (s *stack[T]) Iterator() base.Iterator[T] {
+	return s.linkedList.Iterator()
+}
+
+func (s *stack[T]) All() iter.Seq[T] {
+	return s.linkedList.All()
+}
+
+func (s *stack[T]) Backward() iter.Seq2[int, T] {
+	return s.linkedList.Backward()
+}
+
+func (s *stack[T]) Equals(other base.Collection[T]) bool {
+	o, ok := other.(Stack[T])
+	if !ok || s.Size() != o.Size() {
+		return false
+	}
+	return slices.EqualFunc(s.Values(), o.Values(), s.equal)
+}
+
+func (s *stack[T]) Hash(seed maphash.Seed) uint64 {
+	return s.linkedList.Hash(seed)
+}
+
+func (s *stack[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(s.Values())
+}
+
+func (s *stack[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *stack[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(s.Values())
+}
+
+func (s *stack[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	s.replace(values)
+	return nil
+}
+
+func (s *stack[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+func (s *stack[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the stack with the decoded values.
+func (s *stack[T]) replace(values []T) {
+	s.linkedList.Clear()
+	s.linkedList.AddAll(values...)
+}
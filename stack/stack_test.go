@@ -1,6 +1,10 @@
 package stack
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"hash/maphash"
 	"slices"
 	"testing"
 )
@@ -16,6 +20,33 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewFunc(t *testing.T) {
+	stack := NewFunc(
+		func(a, b float64) bool { return int(a) == int(b) },
+		func(seed maphash.Seed, f float64) uint64 { return maphash.Comparable(seed, int(f)) },
+	)
+	stack.Push(1.2)
+
+	if !stack.Contains(1.8) {
+		t.Fatalf("Expected stack to contain 1.8 using the custom equality")
+	}
+	if stack.Contains(2.1) {
+		t.Fatalf("Expected stack to not contain 2.1")
+	}
+}
+
+func TestNewComparable(t *testing.T) {
+	stack := NewComparable[string]()
+	stack.Push("a")
+
+	if !stack.Contains("a") {
+		t.Fatalf("Expected stack to contain a")
+	}
+	if stack.Contains("b") {
+		t.Fatalf("Expected stack to not contain b")
+	}
+}
+
 func TestStack_Push(t *testing.T) {
 	stack := New[int]()
 	stack.Push(1)
@@ -158,3 +189,136 @@ func TestStack_Values(t *testing.T) {
 		t.Fatalf("Expected stack.Slice() to return %v, but got %v", expected, slice)
 	}
 }
+
+func TestStack_Iterator(t *testing.T) {
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+
+	it := stack.Iterator()
+	for it.HasNext() {
+		if val, _ := it.Next(); *val == 2 {
+			it.Remove()
+		}
+	}
+
+	expected := []int{1, 3}
+	if slice := stack.Values(); !slices.Equal(slice, expected) {
+		t.Fatalf("Expected stack to be %v after removing 2, but got %v", expected, slice)
+	}
+}
+
+func TestStack_All(t *testing.T) {
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+
+	expected := []int{1, 2, 3}
+	if slice := slices.Collect(stack.All()); !slices.Equal(slice, expected) {
+		t.Fatalf("Expected stack.All() to yield %v, but got %v", expected, slice)
+	}
+}
+
+func TestStack_Backward(t *testing.T) {
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+
+	var slice []int
+	for _, v := range stack.Backward() {
+		slice = append(slice, v)
+	}
+	expected := []int{3, 2, 1}
+	if !slices.Equal(slice, expected) {
+		t.Fatalf("Expected stack.Backward() to yield %v, but got %v", expected, slice)
+	}
+}
+
+func TestStack_JSON(t *testing.T) {
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+
+	data, err := json.Marshal(stack)
+	if err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if string(data) != "[1,2,3]" {
+		t.Fatalf("Expected stack to be encoded top-last as [1,2,3], but got %s", data)
+	}
+
+	decoded := New[int]()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if val, _ := decoded.Peek(); *val != 3 {
+		t.Fatalf("Expected decoded stack to have 3 on top, but got %v", *val)
+	}
+	if slice := decoded.Values(); !slices.Equal(slice, []int{1, 2, 3}) {
+		t.Fatalf("Expected decoded stack to be [1 2 3], but got %v", slice)
+	}
+}
+
+func TestStack_Gob(t *testing.T) {
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(stack); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	decoded := New[int]()
+	decoded.Push(9)
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Fatalf("Expected no error, but got %v", err)
+	}
+	if slice := decoded.Values(); !slices.Equal(slice, []int{1, 2}) {
+		t.Fatalf("Expected decoded stack to be [1 2], but got %v", slice)
+	}
+}
+
+func TestStack_Equals(t *testing.T) {
+	a := New[int]()
+	b := NewComparable[int]()
+	for i := range 3 {
+		a.Push(i)
+		b.Push(i)
+	}
+	if !a.Equals(b) || !b.Equals(a) {
+		t.Fatalf("Expected stacks with the same elements to be equal")
+	}
+
+	b.Pop()
+	if a.Equals(b) {
+		t.Fatalf("Expected stacks of different sizes to not be equal")
+	}
+	b.Push(5)
+	if a.Equals(b) {
+		t.Fatalf("Expected stacks with different tops to not be equal")
+	}
+	if !New[int]().Equals(New[int]()) {
+		t.Fatalf("Expected empty stacks to be equal")
+	}
+}
+
+func TestStack_Hash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	a := New[string]()
+	b := New[string]()
+	for _, s := range []string{"a", "b"} {
+		a.Push(s)
+		b.Push(s)
+	}
+	if a.Hash(seed) != b.Hash(seed) {
+		t.Fatalf("Expected equal stacks to have the same hash")
+	}
+	b.Pop()
+	if a.Hash(seed) == b.Hash(seed) {
+		t.Fatalf("Expected different stacks to have different hashes")
+	}
+}
@@ -0,0 +1,300 @@
+// Package stream provides lazy functional operations over collections.
+//
+// Intermediate operations such as Filter and Map return a new Stream without
+// doing any work. The elements are only pulled from the source, one at a time,
+// when a terminal operation such as Reduce or Collect is called, so a pipeline
+// never holds more elements in memory than its operations require.
+package stream
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/heap"
+	"github.com/elias8/go-gather/list"
+	"github.com/elias8/go-gather/maps"
+)
+
+// Stream is a lazy sequence of elements.
+type Stream[T any] struct {
+	seq iter.Seq[T]
+}
+
+// Of returns a Stream over the elements of the collection, in the same order
+// as the collection's All method.
+func Of[T any](c base.Collection[T]) Stream[T] {
+	return Stream[T]{seq: c.All()}
+}
+
+// FromSeq returns a Stream over the elements of the iterator.
+func FromSeq[T any](seq iter.Seq[T]) Stream[T] {
+	return Stream[T]{seq: seq}
+}
+
+// FromValues returns a Stream over the given values.
+func FromValues[T any](values ...T) Stream[T] {
+	return Stream[T]{seq: slices.Values(values)}
+}
+
+// All returns an iterator over the elements of the stream.
+func (s Stream[T]) All() iter.Seq[T] {
+	return s.seq
+}
+
+// Filter returns a Stream of the elements that satisfy the predicate.
+func (s Stream[T]) Filter(predicate func(T) bool) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := range s.seq {
+			if predicate(v) && !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Map returns a Stream of the results of applying mapper to the elements of
+// the stream.
+func Map[T, R any](s Stream[T], mapper func(T) R) Stream[R] {
+	return Stream[R]{seq: func(yield func(R) bool) {
+		for v := range s.seq {
+			if !yield(mapper(v)) {
+				return
+			}
+		}
+	}}
+}
+
+// FlatMap returns a Stream of the elements of the streams produced by applying
+// mapper to the elements of the stream.
+func FlatMap[T, R any](s Stream[T], mapper func(T) Stream[R]) Stream[R] {
+	return Stream[R]{seq: func(yield func(R) bool) {
+		for v := range s.seq {
+			for r := range mapper(v).seq {
+				if !yield(r) {
+					return
+				}
+			}
+		}
+	}}
+}
+
+// Distinct returns a Stream of the elements of the stream with duplicates
+// removed, keeping the first occurrence of each element.
+func Distinct[T comparable](s Stream[T]) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for v := range s.seq {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Sorted returns a Stream of the elements of the stream sorted by compare.
+// The sort is stable. Unlike the other intermediate operations, it has to
+// consume the whole stream before producing its first element.
+func (s Stream[T]) Sorted(compare func(a, b T) int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		values := slices.SortedStableFunc(s.seq, compare)
+		for _, v := range values {
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Limit returns a Stream of at most the first n elements of the stream.
+func (s Stream[T]) Limit(n int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		for v := range s.seq {
+			if !yield(v) {
+				return
+			}
+			count++
+			if count == n {
+				return
+			}
+		}
+	}}
+}
+
+// Skip returns a Stream of the elements of the stream after discarding the
+// first n elements.
+func (s Stream[T]) Skip(n int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		skipped := 0
+		for v := range s.seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Peek returns a Stream of the elements of the stream that calls action on
+// each element as it is consumed.
+func (s Stream[T]) Peek(action func(T)) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for v := range s.seq {
+			action(v)
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// ForEach calls action on each element of the stream.
+func (s Stream[T]) ForEach(action func(T)) {
+	for v := range s.seq {
+		action(v)
+	}
+}
+
+// Reduce combines the elements of the stream using accumulate, starting from
+// identity, and returns the result.
+func (s Stream[T]) Reduce(identity T, accumulate func(T, T) T) T {
+	result := identity
+	for v := range s.seq {
+		result = accumulate(result, v)
+	}
+	return result
+}
+
+// Fold combines the elements of the stream into a value of a different type
+// using accumulate, starting from identity, and returns the result.
+func Fold[T, R any](s Stream[T], identity R, accumulate func(R, T) R) R {
+	result := identity
+	for v := range s.seq {
+		result = accumulate(result, v)
+	}
+	return result
+}
+
+// Count returns the number of elements in the stream.
+func (s Stream[T]) Count() int {
+	count := 0
+	for range s.seq {
+		count++
+	}
+	return count
+}
+
+// AnyMatch returns true if any element of the stream satisfies the predicate.
+// It stops consuming the stream as soon as the result is known.
+func (s Stream[T]) AnyMatch(predicate func(T) bool) bool {
+	for v := range s.seq {
+		if predicate(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllMatch returns true if every element of the stream satisfies the
+// predicate. It stops consuming the stream as soon as the result is known.
+func (s Stream[T]) AllMatch(predicate func(T) bool) bool {
+	for v := range s.seq {
+		if !predicate(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoneMatch returns true if no element of the stream satisfies the predicate.
+// It stops consuming the stream as soon as the result is known.
+func (s Stream[T]) NoneMatch(predicate func(T) bool) bool {
+	return !s.AnyMatch(predicate)
+}
+
+// ToSlice returns the elements of the stream as a slice.
+func (s Stream[T]) ToSlice() []T {
+	return slices.Collect(s.seq)
+}
+
+// ToList returns the elements of the stream as an ArrayList.
+func (s Stream[T]) ToList() list.List[T] {
+	return Collect(s, list.NewArrayList[T]())
+}
+
+// GroupingBy groups the elements of the stream by the key extracted from them.
+// The keys are ordered by their first occurrence and each group keeps the
+// order of the stream.
+func GroupingBy[T any, K comparable](s Stream[T], key func(T) K) maps.Map[K, list.List[T]] {
+	groups := maps.NewLinkedHashMap[K, list.List[T]]()
+	for v := range s.seq {
+		group := groups.ComputeIfAbsent(key(v), func(K) list.List[T] {
+			return list.NewArrayList[T]()
+		})
+		group.Add(v)
+	}
+	return groups
+}
+
+// PartitioningBy splits the elements of the stream into the ones that satisfy
+// the predicate, stored under true, and the ones that do not, stored under
+// false. Both keys are always present.
+func (s Stream[T]) PartitioningBy(predicate func(T) bool) maps.Map[bool, list.List[T]] {
+	partitions := maps.NewLinkedHashMap[bool, list.List[T]]()
+	partitions.Put(true, list.NewArrayList[T]())
+	partitions.Put(false, list.NewArrayList[T]())
+	for v := range s.seq {
+		partition, _ := partitions.Get(predicate(v))
+		(*partition).Add(v)
+	}
+	return partitions
+}
+
+// Collect adds the elements of the stream to the collection and returns it.
+// The collection must have an Add, Push or Offer method, an Offer method
+// returning a heap.Handle, or an Insert or PushBack method returning a bool, as
+// every go-gather collection does; otherwise Collect panics. Use CollectFunc
+// for other collections.
+func Collect[T any, C base.Collection[T]](s Stream[T], c C) C {
+	var add func(C, T)
+	switch target := any(c).(type) {
+	case interface{ Add(T) }:
+		add = func(_ C, v T) { target.Add(v) }
+	case interface{ Add(T) bool }:
+		add = func(_ C, v T) { target.Add(v) }
+	case interface{ Push(T) }:
+		add = func(_ C, v T) { target.Push(v) }
+	case interface{ Offer(T) }:
+		add = func(_ C, v T) { target.Offer(v) }
+	case interface{ Offer(T) *heap.Handle[T] }:
+		add = func(_ C, v T) { target.Offer(v) }
+	case interface{ Insert(T) bool }:
+		add = func(_ C, v T) { target.Insert(v) }
+	case interface{ PushBack(T) bool }:
+		add = func(_ C, v T) { target.PushBack(v) }
+	default:
+		panic(fmt.Sprintf("stream: cannot add elements to %T, use CollectFunc", c))
+	}
+	return CollectFunc(s, c, add)
+}
+
+// CollectFunc adds the elements of the stream to the collection, in order,
+// using the add function, and returns the collection.
+func CollectFunc[T, C any](s Stream[T], c C, add func(C, T)) C {
+	for v := range s.seq {
+		add(c, v)
+	}
+	return c
+}
@@ -0,0 +1,303 @@
+package stream
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/concurrent"
+	"github.com/elias8/go-gather/heap"
+	"github.com/elias8/go-gather/list"
+	"github.com/elias8/go-gather/queue"
+	"github.com/elias8/go-gather/ring"
+	"github.com/elias8/go-gather/set"
+	"github.com/elias8/go-gather/skiplist"
+	"github.com/elias8/go-gather/stack"
+	"github.com/elias8/go-gather/tree"
+)
+
+func numbers(n int) list.List[int] {
+	l := list.NewArrayList[int]()
+	for i := 1; i <= n; i++ {
+		l.Add(i)
+	}
+	return l
+}
+
+func TestOf(t *testing.T) {
+	l := numbers(3)
+	if values := Of(l).ToSlice(); !slices.Equal(values, []int{1, 2, 3}) {
+		t.Fatalf("Expected [1 2 3], got %v", values)
+	}
+	if values := FromSeq(l.All()).ToSlice(); !slices.Equal(values, []int{1, 2, 3}) {
+		t.Fatalf("Expected [1 2 3], got %v", values)
+	}
+	if count := FromValues[int]().Count(); count != 0 {
+		t.Fatalf("Expected empty stream, got %d elements", count)
+	}
+}
+
+func TestStream_Filter(t *testing.T) {
+	values := Of(numbers(6)).Filter(func(v int) bool { return v%2 == 0 }).ToSlice()
+	if !slices.Equal(values, []int{2, 4, 6}) {
+		t.Fatalf("Expected [2 4 6], got %v", values)
+	}
+}
+
+func TestMap(t *testing.T) {
+	values := Map(Of(numbers(3)), func(v int) string { return strings.Repeat("*", v) }).ToSlice()
+	if !slices.Equal(values, []string{"*", "**", "***"}) {
+		t.Fatalf("Expected [* ** ***], got %v", values)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	values := FlatMap(FromValues("a b", "c"), func(s string) Stream[string] {
+		return FromValues(strings.Fields(s)...)
+	}).ToSlice()
+	if !slices.Equal(values, []string{"a", "b", "c"}) {
+		t.Fatalf("Expected [a b c], got %v", values)
+	}
+	first := FlatMap(FromValues(1, 2), func(v int) Stream[int] { return FromValues(v, v) }).Limit(1).ToSlice()
+	if !slices.Equal(first, []int{1}) {
+		t.Fatalf("Expected [1], got %v", first)
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	values := Distinct(FromValues(3, 1, 3, 2, 1)).ToSlice()
+	if !slices.Equal(values, []int{3, 1, 2}) {
+		t.Fatalf("Expected [3 1 2], got %v", values)
+	}
+}
+
+func TestStream_Sorted(t *testing.T) {
+	values := FromValues("bb", "a", "cc", "b").Sorted(func(a, b string) int { return len(a) - len(b) }).ToSlice()
+	if !slices.Equal(values, []string{"a", "b", "bb", "cc"}) {
+		t.Fatalf("Expected [a b bb cc], got %v", values)
+	}
+}
+
+func TestStream_LimitAndSkip(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		stream   Stream[int]
+		expected []int
+	}{
+		{name: "limit", stream: Of(numbers(5)).Limit(2), expected: []int{1, 2}},
+		{name: "limit zero", stream: Of(numbers(5)).Limit(0), expected: nil},
+		{name: "limit more than size", stream: Of(numbers(2)).Limit(5), expected: []int{1, 2}},
+		{name: "skip", stream: Of(numbers(5)).Skip(3), expected: []int{4, 5}},
+		{name: "skip more than size", stream: Of(numbers(2)).Skip(5), expected: nil},
+		{name: "skip then limit", stream: Of(numbers(5)).Skip(1).Limit(2), expected: []int{2, 3}},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			if values := s.stream.ToSlice(); !slices.Equal(values, s.expected) {
+				t.Fatalf("Expected %v, got %v", s.expected, values)
+			}
+		})
+	}
+}
+
+func TestStream_Laziness(t *testing.T) {
+	pulled := 0
+	s := Of(numbers(100000)).
+		Peek(func(int) { pulled++ }).
+		Filter(func(v int) bool { return v%10 == 0 }).
+		Limit(3)
+
+	if pulled != 0 {
+		t.Fatalf("Expected no element to be pulled before a terminal operation, got %d", pulled)
+	}
+	if values := s.ToSlice(); !slices.Equal(values, []int{10, 20, 30}) {
+		t.Fatalf("Expected [10 20 30], got %v", values)
+	}
+	if pulled != 30 {
+		t.Fatalf("Expected 30 elements to be pulled, got %d", pulled)
+	}
+}
+
+func TestStream_Reduce(t *testing.T) {
+	if sum := Of(numbers(4)).Reduce(0, func(a, b int) int { return a + b }); sum != 10 {
+		t.Fatalf("Expected sum 10, got %d", sum)
+	}
+	joined := Fold(Of(numbers(3)), "", func(acc string, v int) string { return acc + strings.Repeat("*", v) })
+	if joined != "******" {
+		t.Fatalf("Expected ******, got %s", joined)
+	}
+}
+
+func TestStream_Matching(t *testing.T) {
+	s := Of(numbers(5))
+	pulled := 0
+	if !s.Peek(func(int) { pulled++ }).AnyMatch(func(v int) bool { return v == 2 }) {
+		t.Fatalf("Expected AnyMatch to find 2")
+	}
+	if pulled != 2 {
+		t.Fatalf("Expected AnyMatch to stop after 2 elements, pulled %d", pulled)
+	}
+	if !s.AllMatch(func(v int) bool { return v > 0 }) {
+		t.Fatalf("Expected AllMatch to hold for positive numbers")
+	}
+	if s.AllMatch(func(v int) bool { return v > 1 }) {
+		t.Fatalf("Expected AllMatch to fail for 1")
+	}
+	if !s.NoneMatch(func(v int) bool { return v > 5 }) {
+		t.Fatalf("Expected NoneMatch to hold for numbers greater than 5")
+	}
+}
+
+func TestStream_ForEachAndCount(t *testing.T) {
+	sum := 0
+	Of(numbers(3)).ForEach(func(v int) { sum += v })
+	if sum != 6 {
+		t.Fatalf("Expected sum 6, got %d", sum)
+	}
+	if count := Of(numbers(3)).Count(); count != 3 {
+		t.Fatalf("Expected count 3, got %d", count)
+	}
+}
+
+func TestGroupingBy(t *testing.T) {
+	groups := GroupingBy(FromValues("apple", "avocado", "banana", "cherry", "blueberry"), func(s string) byte {
+		return s[0]
+	})
+	if keys := groups.Keys().Values(); !slices.Equal(keys, []byte{'a', 'b', 'c'}) {
+		t.Fatalf("Expected keys [a b c], got %v", keys)
+	}
+	if group, _ := groups.Get('b'); !slices.Equal((*group).Values(), []string{"banana", "blueberry"}) {
+		t.Fatalf("Expected group b to be [banana blueberry], got %v", (*group).Values())
+	}
+}
+
+func TestStream_PartitioningBy(t *testing.T) {
+	partitions := Of(numbers(5)).PartitioningBy(func(v int) bool { return v%2 == 0 })
+	even, _ := partitions.Get(true)
+	odd, _ := partitions.Get(false)
+	if !slices.Equal((*even).Values(), []int{2, 4}) {
+		t.Fatalf("Expected even partition [2 4], got %v", (*even).Values())
+	}
+	if !slices.Equal((*odd).Values(), []int{1, 3, 5}) {
+		t.Fatalf("Expected odd partition [1 3 5], got %v", (*odd).Values())
+	}
+
+	empty := FromValues[int]().PartitioningBy(func(int) bool { return true })
+	if empty.Size() != 2 {
+		t.Fatalf("Expected both partitions to be present, got %d", empty.Size())
+	}
+}
+
+func TestCollect(t *testing.T) {
+	s := FromValues(1, 2, 2, 3)
+
+	if values := s.ToList().Values(); !slices.Equal(values, []int{1, 2, 2, 3}) {
+		t.Fatalf("Expected list [1 2 2 3], got %v", values)
+	}
+	if values := Collect(s, set.NewTreeSet[int]()).Values(); !slices.Equal(values, []int{1, 2, 3}) {
+		t.Fatalf("Expected set [1 2 3], got %v", values)
+	}
+	if top, _ := Collect(s, stack.New[int]()).Peek(); *top != 3 {
+		t.Fatalf("Expected stack top 3, got %d", *top)
+	}
+	if head, _ := Collect(s, queue.New[int]()).Peek(); *head != 1 {
+		t.Fatalf("Expected queue head 1, got %d", *head)
+	}
+	if values := Collect(s, tree.NewRedBlack[int]()).Values(); !slices.Equal(values, []int{1, 2, 3}) {
+		t.Fatalf("Expected tree [1 2 3], got %v", values)
+	}
+	if values := Collect(s, ring.New[int](3, ring.Overwrite)).Values(); !slices.Equal(values, []int{2, 2, 3}) {
+		t.Fatalf("Expected buffer [2 2 3], got %v", values)
+	}
+}
+
+func TestCollect_EveryCollection(t *testing.T) {
+	scenarios := []struct {
+		name       string
+		collection base.Collection[int]
+	}{
+		{"ArrayList", list.NewArrayList[int]()},
+		{"ArrayListFunc", list.NewArrayListFunc(base.Equal[int], base.Hash[int])},
+		{"ArrayListWithCapacity", list.NewArrayListWithCapacity[int](1)},
+		{"ArrayListWithCapacityFunc", list.NewArrayListWithCapacityFunc(1, base.Equal[int], base.Hash[int])},
+		{"ArrayListFrom", list.NewArrayListFrom[int](nil)},
+		{"ArrayListFromFunc", list.NewArrayListFromFunc(nil, base.Equal[int], base.Hash[int])},
+		{"ComparableArrayList", list.NewComparableArrayList[int]()},
+		{"ComparableArrayListWithCapacity", list.NewComparableArrayListWithCapacity[int](1)},
+		{"ComparableArrayListFrom", list.NewComparableArrayListFrom[int](nil)},
+		{"LinkedList", list.NewLinkedList[int]()},
+		{"LinkedListFunc", list.NewLinkedListFunc(base.Equal[int], base.Hash[int])},
+		{"ComparableLinkedList", list.NewComparableLinkedList[int]()},
+		{"HashSet", set.NewHashSet[int]()},
+		{"HashSetFunc", set.NewHashSetFunc(base.Equal[int], base.Hash[int])},
+		{"LinkedHashSet", set.NewLinkedHashSet[int]()},
+		{"LinkedHashSetFunc", set.NewLinkedHashSetFunc(base.Equal[int], base.Hash[int])},
+		{"TreeSet", set.NewTreeSet[int]()},
+		{"TreeSetFunc", set.NewTreeSetFunc(cmp.Compare[int], base.Hash[int])},
+		{"Stack", stack.New[int]()},
+		{"StackFunc", stack.NewFunc(base.Equal[int], base.Hash[int])},
+		{"ComparableStack", stack.NewComparable[int]()},
+		{"Queue", queue.New[int]()},
+		{"QueueFunc", queue.NewFunc(base.Equal[int])},
+		{"ComparableQueue", queue.NewComparable[int]()},
+		{"Deque", queue.NewDeque[int]()},
+		{"DequeFunc", queue.NewDequeFunc(base.Equal[int])},
+		{"ComparableDeque", queue.NewComparableDeque[int]()},
+		{"ArrayDeque", queue.NewArrayDeque[int]()},
+		{"ArrayDequeFunc", queue.NewArrayDequeFunc(base.Equal[int])},
+		{"ComparableArrayDeque", queue.NewComparableArrayDeque[int]()},
+		{"Heap", heap.New[int]()},
+		{"HeapFunc", heap.NewFunc(cmp.Compare[int], base.Equal[int])},
+		{"ComparableHeapFunc", heap.NewComparableFunc(cmp.Compare[int])},
+		{"DAryHeap", heap.NewDAry[int](4)},
+		{"DAryHeapFunc", heap.NewDAryFunc(4, cmp.Compare[int], base.Equal[int])},
+		{"ComparableDAryHeapFunc", heap.NewComparableDAryFunc(4, cmp.Compare[int])},
+		{"PairingHeap", heap.NewPairing[int]()},
+		{"PairingHeapFunc", heap.NewPairingFunc(cmp.Compare[int], base.Equal[int])},
+		{"ComparablePairingHeapFunc", heap.NewComparablePairingFunc(cmp.Compare[int])},
+		{"IndexedHeap", heap.NewIndexed[int]()},
+		{"IndexedHeapFunc", heap.NewIndexedFunc(cmp.Compare[int], base.Equal[int])},
+		{"ComparableIndexedHeapFunc", heap.NewComparableIndexedFunc(cmp.Compare[int])},
+		{"RedBlackTree", tree.NewRedBlack[int]()},
+		{"RedBlackTreeFunc", tree.NewRedBlackFunc(cmp.Compare[int])},
+		{"AVLTree", tree.NewAVL[int]()},
+		{"AVLTreeFunc", tree.NewAVLFunc(cmp.Compare[int])},
+		{"SkipList", skiplist.New[int]()},
+		{"SkipListFunc", skiplist.NewFunc(cmp.Compare[int])},
+		{"SeededSkipList", skiplist.NewSeeded[int](1)},
+		{"SeededSkipListFunc", skiplist.NewSeededFunc(cmp.Compare[int], 1)},
+		{"ConcurrentSkipList", skiplist.NewConcurrent[int]()},
+		{"ConcurrentSkipListFunc", skiplist.NewConcurrentFunc(cmp.Compare[int])},
+		{"ConcurrentSeededSkipListFunc", skiplist.NewConcurrentSeededFunc(cmp.Compare[int], 1)},
+		{"Ring", ring.New[int](3, ring.Reject)},
+		{"RingFunc", ring.NewFunc(3, ring.Reject, base.Equal[int])},
+		{"ComparableRing", ring.NewComparable[int](3, ring.Reject)},
+		{"LockFreeQueue", concurrent.NewLockFreeQueue[int]()},
+		{"LockFreeQueueFunc", concurrent.NewLockFreeQueueFunc(base.Equal[int])},
+		{"ComparableLockFreeQueue", concurrent.NewComparableLockFreeQueue[int]()},
+		{"LockFreeStack", concurrent.NewLockFreeStack[int]()},
+		{"LockFreeStackFunc", concurrent.NewLockFreeStackFunc(base.Equal[int], base.Hash[int])},
+		{"ComparableLockFreeStack", concurrent.NewComparableLockFreeStack[int]()},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			c := Collect(FromValues(3, 1, 2), scenario.collection)
+			if c.Size() != 3 || !c.Contains(1) || !c.Contains(2) || !c.Contains(3) {
+				t.Fatalf("Expected the collection to hold [1 2 3], got %v", c.Values())
+			}
+		})
+	}
+}
+
+func TestCollectFunc(t *testing.T) {
+	reversed := CollectFunc(FromValues(1, 2, 3), list.NewLinkedList[int](), func(l list.LinkedList[int], v int) {
+		l.PushFront(v)
+	})
+	if values := reversed.Values(); !slices.Equal(values, []int{3, 2, 1}) {
+		t.Fatalf("Expected list [3 2 1], got %v", values)
+	}
+}
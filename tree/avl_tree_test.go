@@ -0,0 +1,52 @@
+package tree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// checkAVL verifies that the subtree rooted at n is balanced and has correct
+// heights and sizes, and returns its height.
+func checkAVL[T any](t *testing.T, n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	left, right := checkAVL(t, n.left), checkAVL(t, n.right)
+	if left-right > 1 || right-left > 1 {
+		t.Fatalf("Expected subtree heights to differ by at most one, but got %v and %v", left, right)
+	}
+	if n.height != 1+max(left, right) {
+		t.Fatalf("Expected node height %v, but got %v", 1+max(left, right), n.height)
+	}
+	if n.size != 1+sizeOf(n.left)+sizeOf(n.right) {
+		t.Fatalf("Expected node size %v, but got %v", 1+sizeOf(n.left)+sizeOf(n.right), n.size)
+	}
+	return n.height
+}
+
+func TestAVLTree_Invariants(t *testing.T) {
+	tree := NewAVL[int]().(*avlTree[int])
+	r := rand.New(rand.NewSource(2))
+	for i := range 5000 {
+		if v := r.Intn(500); r.Intn(2) == 0 {
+			tree.Insert(v)
+		} else {
+			tree.Delete(v)
+		}
+		if i%50 == 0 {
+			checkAVL(t, tree.root)
+		}
+	}
+	checkAVL(t, tree.root)
+}
+
+func TestAVLTree_Sequential(t *testing.T) {
+	tree := NewAVL[int]().(*avlTree[int])
+	for i := range 1023 {
+		tree.Insert(i)
+	}
+
+	if height := checkAVL(t, tree.root); height != 10 {
+		t.Fatalf("Expected 1023 sequential inserts to build a perfect tree of height 10, but got %v", height)
+	}
+}
@@ -0,0 +1,173 @@
+package tree
+
+import "cmp"
+
+// redBlackTree is a Tree implemented as a left-leaning red-black tree, a
+// red-black tree in which red links always lean left. Its height is at most
+// 2 log n.
+type redBlackTree[T any] struct {
+	binaryTree[T]
+}
+
+// NewRedBlack returns an empty red-black Tree that orders its elements using
+// their natural ordering.
+func NewRedBlack[T cmp.Ordered]() Tree[T] {
+	return NewRedBlackFunc(cmp.Compare[T])
+}
+
+// NewRedBlackFunc returns an empty red-black Tree that orders its elements
+// using the given compare function, which returns a negative number when a <
+// b, a positive number when a > b and zero when a == b.
+func NewRedBlackFunc[T any](compare func(a, b T) int) Tree[T] {
+	t := &redBlackTree[T]{binaryTree[T]{compare: compare, name: "RedBlackTree"}}
+	t.self = t
+	return t
+}
+
+func (t *redBlackTree[T]) Insert(element T) bool {
+	var inserted bool
+	t.root, inserted = t.insert(t.root, element)
+	t.root.red = false
+	return inserted
+}
+
+func (t *redBlackTree[T]) Delete(element T) bool {
+	if !t.Contains(element) {
+		return false
+	}
+	if !isRed(t.root.left) && !isRed(t.root.right) {
+		t.root.red = true
+	}
+	t.root = t.delete(t.root, element)
+	if t.root != nil {
+		t.root.red = false
+	}
+	return true
+}
+
+func (t *redBlackTree[T]) insert(n *node[T], element T) (*node[T], bool) {
+	if n == nil {
+		return &node[T]{value: element, size: 1, red: true}, true
+	}
+	inserted := false
+	switch c := t.compare(element, n.value); {
+	case c < 0:
+		n.left, inserted = t.insert(n.left, element)
+	case c > 0:
+		n.right, inserted = t.insert(n.right, element)
+	default:
+		n.value = element
+	}
+	return balanceRedBlack(n), inserted
+}
+
+// delete removes element, which must be present, from the subtree rooted at
+// n. On the way down it keeps the current node or one of its children red, so
+// that the node eventually removed is never a black leaf.
+func (t *redBlackTree[T]) delete(n *node[T], element T) *node[T] {
+	if t.compare(element, n.value) < 0 {
+		if !isRed(n.left) && !isRed(n.left.left) {
+			n = moveRedLeft(n)
+		}
+		n.left = t.delete(n.left, element)
+		return balanceRedBlack(n)
+	}
+	if isRed(n.left) {
+		n = rotateRedBlackRight(n)
+	}
+	if t.compare(element, n.value) == 0 && n.right == nil {
+		return nil
+	}
+	if !isRed(n.right) && !isRed(n.right.left) {
+		n = moveRedRight(n)
+	}
+	if t.compare(element, n.value) == 0 {
+		n.value = minNode(n.right).value
+		n.right = deleteRedBlackMin(n.right)
+	} else {
+		n.right = t.delete(n.right, element)
+	}
+	return balanceRedBlack(n)
+}
+
+func deleteRedBlackMin[T any](n *node[T]) *node[T] {
+	if n.left == nil {
+		return nil
+	}
+	if !isRed(n.left) && !isRed(n.left.left) {
+		n = moveRedLeft(n)
+	}
+	n.left = deleteRedBlackMin(n.left)
+	return balanceRedBlack(n)
+}
+
+func isRed[T any](n *node[T]) bool {
+	return n != nil && n.red
+}
+
+func rotateRedBlackLeft[T any](n *node[T]) *node[T] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	x.red = n.red
+	n.red = true
+	x.size = n.size
+	n.size = 1 + sizeOf(n.left) + sizeOf(n.right)
+	return x
+}
+
+func rotateRedBlackRight[T any](n *node[T]) *node[T] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	x.red = n.red
+	n.red = true
+	x.size = n.size
+	n.size = 1 + sizeOf(n.left) + sizeOf(n.right)
+	return x
+}
+
+func flipColors[T any](n *node[T]) {
+	n.red = !n.red
+	n.left.red = !n.left.red
+	n.right.red = !n.right.red
+}
+
+// moveRedLeft makes n.left or one of its children red, assuming n is red and
+// both n.left and n.left.left are black.
+func moveRedLeft[T any](n *node[T]) *node[T] {
+	flipColors(n)
+	if isRed(n.right.left) {
+		n.right = rotateRedBlackRight(n.right)
+		n = rotateRedBlackLeft(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// moveRedRight makes n.right or one of its children red, assuming n is red
+// and both n.right and n.right.left are black.
+func moveRedRight[T any](n *node[T]) *node[T] {
+	flipColors(n)
+	if isRed(n.left.left) {
+		n = rotateRedBlackRight(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// balanceRedBlack restores the left-leaning red-black invariants at n and
+// updates its size.
+func balanceRedBlack[T any](n *node[T]) *node[T] {
+	if isRed(n.right) && !isRed(n.left) {
+		n = rotateRedBlackLeft(n)
+	}
+	if isRed(n.left) && isRed(n.left.left) {
+		n = rotateRedBlackRight(n)
+	}
+	if isRed(n.left) && isRed(n.right) {
+		flipColors(n)
+	}
+	n.size = 1 + sizeOf(n.left) + sizeOf(n.right)
+	return n
+}
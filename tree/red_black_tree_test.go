@@ -0,0 +1,50 @@
+package tree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// checkRedBlack verifies that the subtree rooted at n is a valid left-leaning
+// red-black tree with correct sizes, and returns its black height.
+func checkRedBlack[T any](t *testing.T, n *node[T]) int {
+	if n == nil {
+		return 1
+	}
+	if isRed(n.right) {
+		t.Fatalf("Expected red links to lean left")
+	}
+	if isRed(n) && isRed(n.left) {
+		t.Fatalf("Expected no two consecutive red links")
+	}
+	if n.size != 1+sizeOf(n.left)+sizeOf(n.right) {
+		t.Fatalf("Expected node size %v, but got %v", 1+sizeOf(n.left)+sizeOf(n.right), n.size)
+	}
+	left, right := checkRedBlack(t, n.left), checkRedBlack(t, n.right)
+	if left != right {
+		t.Fatalf("Expected every path to have the same number of black links")
+	}
+	if !n.red {
+		left++
+	}
+	return left
+}
+
+func TestRedBlackTree_Invariants(t *testing.T) {
+	tree := NewRedBlack[int]().(*redBlackTree[int])
+	r := rand.New(rand.NewSource(2))
+	for i := range 5000 {
+		if v := r.Intn(500); r.Intn(2) == 0 {
+			tree.Insert(v)
+		} else {
+			tree.Delete(v)
+		}
+		if i%50 == 0 {
+			checkRedBlack(t, tree.root)
+		}
+	}
+	if isRed(tree.root) {
+		t.Fatalf("Expected the root to be black")
+	}
+	checkRedBlack(t, tree.root)
+}
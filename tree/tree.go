@@ -0,0 +1,432 @@
+// Package tree provides self-balancing binary search trees that keep their
+// elements sorted by a compare function.
+package tree
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+	"github.com/elias8/go-gather/internal/encoding"
+)
+
+// Tree is a self-balancing binary search tree of distinct elements, ordered by
+// the compare function it was created with. Two elements are considered equal
+// if compare returns zero. Insert, Delete, Search and the other queries take
+// O(log n) time. All, Values and Iterator visit the elements in order.
+//
+// The queries returning a *T return a pointer to a copy of the element, so
+// that it is not changed by later modifications of the tree, and modifying it
+// does not affect the tree.
+type Tree[T any] interface {
+	base.Collection[T]
+
+	// Insert adds the element to the tree. If the tree already holds an equal
+	// element, it is replaced by element and Insert returns false.
+	Insert(element T) bool
+
+	// Delete removes the element equal to element from the tree. Returns false
+	// if the tree does not contain such an element.
+	Delete(element T) bool
+
+	// Search returns the element of the tree equal to element. Returns nil and
+	// false if the tree does not contain such an element.
+	Search(element T) (*T, bool)
+
+	// Min returns the smallest element of the tree. Returns nil and false if
+	// the tree is empty.
+	Min() (*T, bool)
+
+	// Max returns the largest element of the tree. Returns nil and false if
+	// the tree is empty.
+	Max() (*T, bool)
+
+	// Floor returns the largest element less than or equal to element.
+	// Returns nil and false if there is no such element.
+	Floor(element T) (*T, bool)
+
+	// Ceiling returns the smallest element greater than or equal to element.
+	// Returns nil and false if there is no such element.
+	Ceiling(element T) (*T, bool)
+
+	// Predecessor returns the largest element strictly less than element.
+	// Returns nil and false if there is no such element.
+	Predecessor(element T) (*T, bool)
+
+	// Successor returns the smallest element strictly greater than element.
+	// Returns nil and false if there is no such element.
+	Successor(element T) (*T, bool)
+
+	// Rank returns the number of elements of the tree that are strictly less
+	// than element.
+	Rank(element T) int
+
+	// Select returns the element with the given rank, that is the element
+	// that has exactly rank smaller elements in the tree. Returns nil and
+	// false if the rank is out of range (rank < 0 || rank >= Size()).
+	Select(rank int) (*T, bool)
+
+	// InOrder returns an iterator over the elements of the tree in ascending
+	// order.
+	InOrder() iter.Seq[T]
+
+	// PreOrder returns an iterator over the elements of the tree, visiting
+	// every node before its left and right subtrees.
+	PreOrder() iter.Seq[T]
+
+	// PostOrder returns an iterator over the elements of the tree, visiting
+	// every node after its left and right subtrees.
+	PostOrder() iter.Seq[T]
+
+	// LevelOrder returns an iterator over the elements of the tree, visiting
+	// the nodes level by level from the root, and from left to right within
+	// a level.
+	LevelOrder() iter.Seq[T]
+}
+
+// node is a node of a binary search tree. size is the number of nodes in the
+// subtree rooted at the node, which is used by Rank and Select. Red-black
+// trees use red and AVL trees use height to keep themselves balanced.
+type node[T any] struct {
+	value  T
+	left   *node[T]
+	right  *node[T]
+	size   int
+	height int
+	red    bool
+}
+
+func sizeOf[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// binaryTree implements the operations shared by every balanced tree: the
+// ones that only read the tree. self is the tree that embeds it, which
+// provides Insert and Delete.
+type binaryTree[T any] struct {
+	root    *node[T]
+	compare func(a, b T) int
+	name    string
+	self    Tree[T]
+}
+
+func (t *binaryTree[T]) Size() int {
+	return sizeOf(t.root)
+}
+
+func (t *binaryTree[T]) IsEmpty() bool {
+	return t.root == nil
+}
+
+func (t *binaryTree[T]) Contains(element T) bool {
+	return t.search(element) != nil
+}
+
+func (t *binaryTree[T]) Values() []T {
+	var values []T
+	for v := range t.InOrder() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (t *binaryTree[T]) Clear() {
+	t.root = nil
+}
+
+func (t *binaryTree[T]) String() string {
+	str := t.name + "(["
+	for i, v := range t.Values() {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", v)
+	}
+	return str + "])"
+}
+
+func (t *binaryTree[T]) Iterator() base.Iterator[T] {
+	return &treeIterator[T]{tree: t.self}
+}
+
+func (t *binaryTree[T]) All() iter.Seq[T] {
+	return t.InOrder()
+}
+
+func (t *binaryTree[T]) Search(element T) (*T, bool) {
+	return valueOf(t.search(element))
+}
+
+func (t *binaryTree[T]) Min() (*T, bool) {
+	if t.root == nil {
+		return nil, false
+	}
+	return valueOf(minNode(t.root))
+}
+
+func (t *binaryTree[T]) Max() (*T, bool) {
+	n := t.root
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return valueOf(n)
+}
+
+func (t *binaryTree[T]) Floor(element T) (*T, bool) {
+	var floor *node[T]
+	for n := t.root; n != nil; {
+		c := t.compare(element, n.value)
+		if c == 0 {
+			return valueOf(n)
+		}
+		if c < 0 {
+			n = n.left
+		} else {
+			floor, n = n, n.right
+		}
+	}
+	return valueOf(floor)
+}
+
+func (t *binaryTree[T]) Ceiling(element T) (*T, bool) {
+	var ceiling *node[T]
+	for n := t.root; n != nil; {
+		c := t.compare(element, n.value)
+		if c == 0 {
+			return valueOf(n)
+		}
+		if c > 0 {
+			n = n.right
+		} else {
+			ceiling, n = n, n.left
+		}
+	}
+	return valueOf(ceiling)
+}
+
+func (t *binaryTree[T]) Predecessor(element T) (*T, bool) {
+	var predecessor *node[T]
+	for n := t.root; n != nil; {
+		if t.compare(element, n.value) <= 0 {
+			n = n.left
+		} else {
+			predecessor, n = n, n.right
+		}
+	}
+	return valueOf(predecessor)
+}
+
+func (t *binaryTree[T]) Successor(element T) (*T, bool) {
+	var successor *node[T]
+	for n := t.root; n != nil; {
+		if t.compare(element, n.value) >= 0 {
+			n = n.right
+		} else {
+			successor, n = n, n.left
+		}
+	}
+	return valueOf(successor)
+}
+
+func (t *binaryTree[T]) Rank(element T) int {
+	rank := 0
+	for n := t.root; n != nil; {
+		c := t.compare(element, n.value)
+		if c <= 0 {
+			if c == 0 {
+				return rank + sizeOf(n.left)
+			}
+			n = n.left
+		} else {
+			rank += sizeOf(n.left) + 1
+			n = n.right
+		}
+	}
+	return rank
+}
+
+func (t *binaryTree[T]) Select(rank int) (*T, bool) {
+	if rank < 0 || rank >= t.Size() {
+		return nil, false
+	}
+	n := t.root
+	for {
+		left := sizeOf(n.left)
+		switch {
+		case rank < left:
+			n = n.left
+		case rank > left:
+			rank -= left + 1
+			n = n.right
+		default:
+			return valueOf(n)
+		}
+	}
+}
+
+func (t *binaryTree[T]) InOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var walk func(n *node[T]) bool
+		walk = func(n *node[T]) bool {
+			return n == nil || walk(n.left) && yield(n.value) && walk(n.right)
+		}
+		walk(t.root)
+	}
+}
+
+func (t *binaryTree[T]) PreOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var walk func(n *node[T]) bool
+		walk = func(n *node[T]) bool {
+			return n == nil || yield(n.value) && walk(n.left) && walk(n.right)
+		}
+		walk(t.root)
+	}
+}
+
+func (t *binaryTree[T]) PostOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var walk func(n *node[T]) bool
+		walk = func(n *node[T]) bool {
+			return n == nil || walk(n.left) && walk(n.right) && yield(n.value)
+		}
+		walk(t.root)
+	}
+}
+
+func (t *binaryTree[T]) LevelOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t.root == nil {
+			return
+		}
+		queue := []*node[T]{t.root}
+		for len(queue) > 0 {
+			n := queue[0]
+			queue = queue[1:]
+			if !yield(n.value) {
+				return
+			}
+			if n.left != nil {
+				queue = append(queue, n.left)
+			}
+			if n.right != nil {
+				queue = append(queue, n.right)
+			}
+		}
+	}
+}
+
+func (t *binaryTree[T]) search(element T) *node[T] {
+	n := t.root
+	for n != nil {
+		c := t.compare(element, n.value)
+		if c == 0 {
+			return n
+		}
+		if c < 0 {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return nil
+}
+
+func minNode[T any](n *node[T]) *node[T] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// valueOf returns a pointer to a copy of the value of the node, because
+// deleting from a tree moves values between its nodes, and modifying a value
+// in place could break the ordering. Returns nil and false if n is nil.
+func valueOf[T any](n *node[T]) (*T, bool) {
+	if n == nil {
+		return nil, false
+	}
+	value := n.value
+	return &value, true
+}
+
+// treeIterator iterates over the elements of a tree in ascending order. It
+// finds every element as the successor of the previous one, so it remains
+// valid when elements are removed through it and the tree is rebalanced.
+type treeIterator[T any] struct {
+	tree    Tree[T]
+	next    *T
+	last    *T
+	started bool
+}
+
+func (it *treeIterator[T]) HasNext() bool {
+	if !it.started {
+		it.next, _ = it.tree.Min()
+		it.started = true
+	}
+	return it.next != nil
+}
+
+func (it *treeIterator[T]) Next() (*T, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	it.last = it.next
+	it.next, _ = it.tree.Successor(*it.last)
+	return it.last, true
+}
+
+func (it *treeIterator[T]) Remove() bool {
+	if it.last == nil {
+		return false
+	}
+	removed := it.tree.Delete(*it.last)
+	it.last = nil
+	return removed
+}
+
+func (t *binaryTree[T]) MarshalJSON() ([]byte, error) {
+	return encoding.MarshalJSON(t.Values())
+}
+
+func (t *binaryTree[T]) UnmarshalJSON(data []byte) error {
+	values, err := encoding.UnmarshalJSON[T](data)
+	if err != nil {
+		return err
+	}
+	t.replace(values)
+	return nil
+}
+
+func (t *binaryTree[T]) MarshalBinary() ([]byte, error) {
+	return encoding.MarshalBinary(t.Values())
+}
+
+func (t *binaryTree[T]) UnmarshalBinary(data []byte) error {
+	values, err := encoding.UnmarshalBinary[T](data)
+	if err != nil {
+		return err
+	}
+	t.replace(values)
+	return nil
+}
+
+func (t *binaryTree[T]) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+func (t *binaryTree[T]) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// replace replaces the elements of the tree with the decoded values.
+func (t *binaryTree[T]) replace(values []T) {
+	t.Clear()
+	for _, v := range values {
+		t.self.Insert(v)
+	}
+}
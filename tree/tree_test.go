@@ -0,0 +1,248 @@
+package tree
+
+import (
+	"encoding/json"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+var constructors = []struct {
+	name string
+	new  func() Tree[int]
+}{
+	{name: "red-black tree", new: NewRedBlack[int]},
+	{name: "AVL tree", new: NewAVL[int]},
+}
+
+func treeOf(newTree func() Tree[int], elements ...int) Tree[int] {
+	t := newTree()
+	for _, e := range elements {
+		t.Insert(e)
+	}
+	return t
+}
+
+func TestTree_InsertDelete(t *testing.T) {
+	for _, c := range constructors {
+		t.Run(c.name, func(t *testing.T) {
+			tree := c.new()
+			r := rand.New(rand.NewSource(1))
+			present := map[int]bool{}
+			for range 2000 {
+				v := r.Intn(300)
+				if r.Intn(3) == 0 {
+					if tree.Delete(v) != present[v] {
+						t.Fatalf("Expected Delete(%v) to return %v", v, present[v])
+					}
+					delete(present, v)
+				} else {
+					if tree.Insert(v) == present[v] {
+						t.Fatalf("Expected Insert(%v) to return %v", v, !present[v])
+					}
+					present[v] = true
+				}
+			}
+
+			var expected []int
+			for v := range present {
+				expected = append(expected, v)
+			}
+			slices.Sort(expected)
+			if tree.Size() != len(expected) {
+				t.Fatalf("Expected tree to have %v elements, but got %v", len(expected), tree.Size())
+			}
+			if values := tree.Values(); !slices.Equal(values, expected) {
+				t.Fatalf("Expected in-order values %v, but got %v", expected, values)
+			}
+			for _, v := range expected {
+				if !tree.Contains(v) {
+					t.Fatalf("Expected tree to contain %v", v)
+				}
+			}
+		})
+	}
+}
+
+func TestTree_Insert_Replace(t *testing.T) {
+	type entry struct{ key, value int }
+	byKey := func(a, b entry) int { return a.key - b.key }
+	for _, tree := range []Tree[entry]{NewRedBlackFunc(byKey), NewAVLFunc(byKey)} {
+		tree.Insert(entry{1, 1})
+		if tree.Insert(entry{1, 2}) {
+			t.Fatalf("Expected Insert of an equal element to return false")
+		}
+		if e, _ := tree.Search(entry{key: 1}); e.value != 2 {
+			t.Fatalf("Expected Insert to replace the equal element, but got %v", *e)
+		}
+	}
+}
+
+func TestTree_Queries(t *testing.T) {
+	type query struct {
+		name     string
+		query    func(tree Tree[int]) (*int, bool)
+		expected int
+		found    bool
+	}
+	scenarios := []query{
+		{name: "Min", query: func(t Tree[int]) (*int, bool) { return t.Min() }, expected: 10, found: true},
+		{name: "Max", query: func(t Tree[int]) (*int, bool) { return t.Max() }, expected: 50, found: true},
+		{name: "Search present", query: func(t Tree[int]) (*int, bool) { return t.Search(30) }, expected: 30, found: true},
+		{name: "Search absent", query: func(t Tree[int]) (*int, bool) { return t.Search(35) }},
+		{name: "Floor present", query: func(t Tree[int]) (*int, bool) { return t.Floor(30) }, expected: 30, found: true},
+		{name: "Floor absent", query: func(t Tree[int]) (*int, bool) { return t.Floor(35) }, expected: 30, found: true},
+		{name: "Floor below min", query: func(t Tree[int]) (*int, bool) { return t.Floor(5) }},
+		{name: "Ceiling present", query: func(t Tree[int]) (*int, bool) { return t.Ceiling(30) }, expected: 30, found: true},
+		{name: "Ceiling absent", query: func(t Tree[int]) (*int, bool) { return t.Ceiling(35) }, expected: 40, found: true},
+		{name: "Ceiling above max", query: func(t Tree[int]) (*int, bool) { return t.Ceiling(55) }},
+		{name: "Predecessor present", query: func(t Tree[int]) (*int, bool) { return t.Predecessor(30) }, expected: 20, found: true},
+		{name: "Predecessor of min", query: func(t Tree[int]) (*int, bool) { return t.Predecessor(10) }},
+		{name: "Successor present", query: func(t Tree[int]) (*int, bool) { return t.Successor(30) }, expected: 40, found: true},
+		{name: "Successor absent", query: func(t Tree[int]) (*int, bool) { return t.Successor(31) }, expected: 40, found: true},
+		{name: "Successor of max", query: func(t Tree[int]) (*int, bool) { return t.Successor(50) }},
+		{name: "Select first", query: func(t Tree[int]) (*int, bool) { return t.Select(0) }, expected: 10, found: true},
+		{name: "Select middle", query: func(t Tree[int]) (*int, bool) { return t.Select(2) }, expected: 30, found: true},
+		{name: "Select out of range", query: func(t Tree[int]) (*int, bool) { return t.Select(5) }},
+	}
+
+	for _, c := range constructors {
+		tree := treeOf(c.new, 30, 10, 50, 20, 40)
+		for _, s := range scenarios {
+			t.Run(c.name+" "+s.name, func(t *testing.T) {
+				v, found := s.query(tree)
+				if found != s.found {
+					t.Fatalf("Expected found to be %v, but got %v", s.found, found)
+				}
+				if found && *v != s.expected {
+					t.Fatalf("Expected %v, but got %v", s.expected, *v)
+				}
+			})
+		}
+	}
+}
+
+func TestTree_QueriesReturnCopies(t *testing.T) {
+	for _, c := range constructors {
+		t.Run(c.name, func(t *testing.T) {
+			// 2 is the root with two children, so deleting it moves the value
+			// of its successor into its node.
+			tree := treeOf(c.new, 2, 1, 3)
+			floor, _ := tree.Floor(2)
+			search, _ := tree.Search(2)
+			selected, _ := tree.Select(1)
+			tree.Delete(2)
+			if *floor != 2 || *search != 2 || *selected != 2 {
+				t.Fatalf("Expected results to keep 2 after Delete, but got %v, %v and %v", *floor, *search, *selected)
+			}
+
+			first, _ := tree.Min()
+			*first = 100
+			if !tree.Contains(1) || tree.Contains(100) {
+				t.Fatalf("Expected modifying a result to leave the tree unchanged, but got %v", tree)
+			}
+		})
+	}
+}
+
+func TestTree_Rank(t *testing.T) {
+	for _, c := range constructors {
+		tree := treeOf(c.new, 30, 10, 50, 20, 40)
+		for element, expected := range map[int]int{5: 0, 10: 0, 25: 2, 30: 2, 50: 4, 60: 5} {
+			if rank := tree.Rank(element); rank != expected {
+				t.Fatalf("%v: expected Rank(%v) to be %v, but got %v", c.name, element, expected, rank)
+			}
+		}
+		for i := range tree.Size() {
+			v, _ := tree.Select(i)
+			if rank := tree.Rank(*v); rank != i {
+				t.Fatalf("%v: expected Rank(Select(%v)) to be %v, but got %v", c.name, i, i, rank)
+			}
+		}
+	}
+}
+
+func TestTree_Traversals(t *testing.T) {
+	for _, c := range constructors {
+		t.Run(c.name, func(t *testing.T) {
+			// Inserting 1 to 7 in this order builds the same perfect tree in
+			// both implementations.
+			tree := treeOf(c.new, 4, 2, 6, 1, 3, 5, 7)
+
+			scenarios := []struct {
+				name     string
+				values   []int
+				expected []int
+			}{
+				{name: "in-order", values: slices.Collect(tree.InOrder()), expected: []int{1, 2, 3, 4, 5, 6, 7}},
+				{name: "pre-order", values: slices.Collect(tree.PreOrder()), expected: []int{4, 2, 1, 3, 6, 5, 7}},
+				{name: "post-order", values: slices.Collect(tree.PostOrder()), expected: []int{1, 3, 2, 5, 7, 6, 4}},
+				{name: "level-order", values: slices.Collect(tree.LevelOrder()), expected: []int{4, 2, 6, 1, 3, 5, 7}},
+			}
+			for _, s := range scenarios {
+				if !slices.Equal(s.values, s.expected) {
+					t.Fatalf("Expected %v traversal %v, but got %v", s.name, s.expected, s.values)
+				}
+			}
+		})
+	}
+}
+
+func TestTree_Iterator(t *testing.T) {
+	for _, c := range constructors {
+		t.Run(c.name, func(t *testing.T) {
+			var elements []int
+			for i := range 100 {
+				elements = append(elements, i)
+			}
+			tree := treeOf(c.new, elements...)
+
+			var visited []int
+			it := tree.Iterator()
+			if it.Remove() {
+				t.Fatalf("Expected Remove to fail before Next is called")
+			}
+			for it.HasNext() {
+				v, _ := it.Next()
+				visited = append(visited, *v)
+				if *v%3 != 0 && !it.Remove() {
+					t.Fatalf("Expected Remove to succeed")
+				}
+			}
+
+			if !slices.Equal(visited, elements) {
+				t.Fatalf("Expected iterator to visit every element once, but got %v", visited)
+			}
+			for i, v := range tree.Values() {
+				if v != 3*i {
+					t.Fatalf("Expected only multiples of 3 to remain, but got %v", tree)
+				}
+			}
+		})
+	}
+}
+
+func TestTree_String(t *testing.T) {
+	if s := treeOf(constructors[0].new, 2, 1).String(); s != "RedBlackTree([1, 2])" {
+		t.Fatalf("Expected RedBlackTree([1, 2]), but got %v", s)
+	}
+	if s := treeOf(constructors[1].new).String(); s != "AVLTree([])" {
+		t.Fatalf("Expected AVLTree([]), but got %v", s)
+	}
+}
+
+func TestTree_JSON(t *testing.T) {
+	for _, c := range constructors {
+		tree := c.new()
+		if err := json.Unmarshal([]byte("[3, 1, 2, 1]"), tree); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		data, err := json.Marshal(tree)
+		if err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if string(data) != "[1,2,3]" {
+			t.Fatalf("%v: expected [1,2,3], but got %s", c.name, data)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package trie
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/elias8/go-gather/base"
+)
+
+// keyView is the collection of the keys of a trie returned by Keys.
+type keyView[K Key, V any] struct {
+	trie *trie[K, V]
+}
+
+func (v *keyView[K, V]) Contains(key K) bool {
+	return v.trie.Contains(key)
+}
+
+func (v *keyView[K, V]) Clear() {
+	v.trie.Clear()
+}
+
+func (v *keyView[K, V]) IsEmpty() bool {
+	return v.trie.IsEmpty()
+}
+
+func (v *keyView[K, V]) Size() int {
+	return v.trie.Size()
+}
+
+func (v *keyView[K, V]) Values() []K {
+	keys := make([]K, 0, v.trie.Size())
+	for k := range v.trie.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (v *keyView[K, V]) String() string {
+	str := "TrieKeys(["
+	for i, k := range v.Values() {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v", string(k))
+	}
+	return str + "])"
+}
+
+func (v *keyView[K, V]) Iterator() base.Iterator[K] {
+	return &keyIterator[K, V]{trie: v.trie, keys: v.Values()}
+}
+
+func (v *keyView[K, V]) All() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range v.trie.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// keyIterator iterates over the keys a trie held when the iterator was
+// created. Remove deletes the last returned key from the trie.
+type keyIterator[K Key, V any] struct {
+	trie   *trie[K, V]
+	keys   []K
+	cursor int
+	last   *K
+}
+
+func (it *keyIterator[K, V]) HasNext() bool {
+	return it.cursor < len(it.keys)
+}
+
+func (it *keyIterator[K, V]) Next() (*K, bool) {
+	if !it.HasNext() {
+		return nil, false
+	}
+	it.last = &it.keys[it.cursor]
+	it.cursor++
+	return it.last, true
+}
+
+func (it *keyIterator[K, V]) Remove() bool {
+	if it.last == nil {
+		return false
+	}
+	_, removed := it.trie.Delete(*it.last)
+	it.last = nil
+	return removed
+}
@@ -0,0 +1,340 @@
+// Package trie provides a radix tree: a trie whose chains of single-child
+// nodes are compressed into one edge labelled with a whole substring. It maps
+// string or byte slice keys to values and answers prefix queries in time
+// proportional to the length of the prefix.
+package trie
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/elias8/go-gather/base"
+)
+
+// Key is the constraint satisfied by the keys of a Trie. Keys are compared
+// byte by byte, so Trie orders them lexicographically by their bytes.
+type Key interface {
+	~string | ~[]byte
+}
+
+// Trie maps keys to values and finds keys by prefix. All, Keys and WalkPrefix
+// visit the keys in lexicographic byte order.
+type Trie[K Key, V any] interface {
+	// Insert associates the value with the key. Returns the previous value
+	// and true if the key was already present, nil and false otherwise.
+	Insert(key K, value V) (*V, bool)
+
+	// Delete removes the key and its value from the trie. Returns the removed
+	// value and true if the key was present, nil and false otherwise.
+	Delete(key K) (*V, bool)
+
+	// Get returns the value associated with the key. Returns nil and false if
+	// the trie does not contain the key.
+	Get(key K) (*V, bool)
+
+	// Contains returns true if the trie contains the key.
+	Contains(key K) bool
+
+	// LongestPrefixMatch returns the longest key of the trie that is a prefix
+	// of key, and its value. Returns false if no key of the trie is a prefix
+	// of key.
+	LongestPrefixMatch(key K) (K, *V, bool)
+
+	// WalkPrefix calls walk for every key of the trie that starts with
+	// prefix, and its value, until walk returns false.
+	WalkPrefix(prefix K, walk func(key K, value V) bool)
+
+	// KeysWithPrefix returns the keys of the trie that start with prefix.
+	KeysWithPrefix(prefix K) []K
+
+	// Keys returns a view of the keys of the trie. The view is backed by the
+	// trie: it reflects later changes to the trie, and removing keys from the
+	// view, through Clear or an iterator, removes them from the trie.
+	Keys() base.Collection[K]
+
+	// All returns an iterator over the key-value pairs of the trie.
+	All() iter.Seq2[K, V]
+
+	// Clear removes all keys from the trie.
+	Clear()
+
+	// IsEmpty returns true if the trie contains no keys.
+	IsEmpty() bool
+
+	// Size returns the number of keys in the trie.
+	Size() int
+
+	// String returns string representation of the trie.
+	String() string
+}
+
+// node is a node of a radix tree. prefix labels the edge from its parent, and
+// the key of the node is the concatenation of the prefixes from the root.
+// Children are sorted by the first byte of their prefix, which is unique
+// among siblings.
+type node[V any] struct {
+	prefix   string
+	children []*node[V]
+	value    V
+	leaf     bool
+}
+
+// child returns the position of the child whose prefix starts with b, or where
+// it would be inserted, and the child if there is one.
+func (n *node[V]) child(b byte) (int, *node[V]) {
+	i, found := slices.BinarySearchFunc(n.children, b, func(c *node[V], b byte) int {
+		return int(c.prefix[0]) - int(b)
+	})
+	if !found {
+		return i, nil
+	}
+	return i, n.children[i]
+}
+
+// merge absorbs the only child of n, whose key is not otherwise needed.
+func (n *node[V]) merge() {
+	c := n.children[0]
+	n.prefix += c.prefix
+	n.children = c.children
+	n.value = c.value
+	n.leaf = c.leaf
+}
+
+type trie[K Key, V any] struct {
+	root *node[V]
+	size int
+}
+
+// New returns an empty Trie.
+func New[K Key, V any]() Trie[K, V] {
+	return &trie[K, V]{root: &node[V]{}}
+}
+
+func (t *trie[K, V]) Insert(key K, value V) (*V, bool) {
+	s := string(key)
+	n := t.root
+	for len(s) > 0 {
+		i, c := n.child(s[0])
+		if c == nil {
+			n.children = slices.Insert(n.children, i, &node[V]{prefix: s, value: value, leaf: true})
+			t.size++
+			return nil, false
+		}
+		common := commonPrefix(s, c.prefix)
+		if common < len(c.prefix) {
+			// Split the edge where the key leaves it.
+			split := &node[V]{prefix: c.prefix[:common], children: []*node[V]{c}}
+			c.prefix = c.prefix[common:]
+			n.children[i] = split
+			c = split
+		}
+		s = s[common:]
+		n = c
+	}
+	if n.leaf {
+		previous := n.value
+		n.value = value
+		return &previous, true
+	}
+	n.value = value
+	n.leaf = true
+	t.size++
+	return nil, false
+}
+
+func (t *trie[K, V]) Delete(key K) (*V, bool) {
+	s := string(key)
+	var parent *node[V]
+	n := t.root
+	for len(s) > 0 {
+		_, c := n.child(s[0])
+		if c == nil || !strings.HasPrefix(s, c.prefix) {
+			return nil, false
+		}
+		parent, n = n, c
+		s = s[len(c.prefix):]
+	}
+	if !n.leaf {
+		return nil, false
+	}
+	removed := n.value
+	var zero V
+	n.value = zero
+	n.leaf = false
+	t.size--
+	if parent == nil {
+		return &removed, true
+	}
+	switch len(n.children) {
+	case 0:
+		i, _ := parent.child(n.prefix[0])
+		parent.children = slices.Delete(parent.children, i, i+1)
+		if parent != t.root && !parent.leaf && len(parent.children) == 1 {
+			parent.merge()
+		}
+	case 1:
+		n.merge()
+	}
+	return &removed, true
+}
+
+// find returns the node whose key is key, whether or not it holds a value.
+func (t *trie[K, V]) find(key string) *node[V] {
+	n := t.root
+	for len(key) > 0 {
+		_, c := n.child(key[0])
+		if c == nil || !strings.HasPrefix(key, c.prefix) {
+			return nil
+		}
+		n = c
+		key = key[len(c.prefix):]
+	}
+	return n
+}
+
+func (t *trie[K, V]) Get(key K) (*V, bool) {
+	n := t.find(string(key))
+	if n == nil || !n.leaf {
+		return nil, false
+	}
+	return &n.value, true
+}
+
+func (t *trie[K, V]) Contains(key K) bool {
+	_, found := t.Get(key)
+	return found
+}
+
+func (t *trie[K, V]) LongestPrefixMatch(key K) (K, *V, bool) {
+	s := string(key)
+	var match *node[V]
+	length := 0
+	n := t.root
+	consumed := 0
+	for {
+		if n.leaf {
+			match, length = n, consumed
+		}
+		if consumed == len(s) {
+			break
+		}
+		_, c := n.child(s[consumed])
+		if c == nil || !strings.HasPrefix(s[consumed:], c.prefix) {
+			break
+		}
+		n = c
+		consumed += len(c.prefix)
+	}
+	if match == nil {
+		var zero K
+		return zero, nil, false
+	}
+	return K(s[:length]), &match.value, true
+}
+
+// seek returns the node with the shortest key that starts with prefix, and
+// that key. Returns nil if no key of the trie starts with prefix.
+func (t *trie[K, V]) seek(prefix string) (*node[V], string) {
+	n := t.root
+	key := ""
+	for len(prefix) > 0 {
+		_, c := n.child(prefix[0])
+		switch {
+		case c == nil:
+			return nil, ""
+		case strings.HasPrefix(prefix, c.prefix):
+			prefix = prefix[len(c.prefix):]
+		case strings.HasPrefix(c.prefix, prefix):
+			prefix = ""
+		default:
+			return nil, ""
+		}
+		key += c.prefix
+		n = c
+	}
+	return n, key
+}
+
+// walk calls visit for the key and value of n and its descendants in
+// lexicographic order, until visit returns false.
+func walk[V any](n *node[V], key string, visit func(key string, value V) bool) bool {
+	if n.leaf && !visit(key, n.value) {
+		return false
+	}
+	for _, c := range n.children {
+		if !walk(c, key+c.prefix, visit) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *trie[K, V]) WalkPrefix(prefix K, visit func(key K, value V) bool) {
+	n, key := t.seek(string(prefix))
+	if n == nil {
+		return
+	}
+	walk(n, key, func(key string, value V) bool {
+		return visit(K(key), value)
+	})
+}
+
+func (t *trie[K, V]) KeysWithPrefix(prefix K) []K {
+	var keys []K
+	t.WalkPrefix(prefix, func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+func (t *trie[K, V]) Keys() base.Collection[K] {
+	return &keyView[K, V]{trie: t}
+}
+
+func (t *trie[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		walk(t.root, "", func(key string, value V) bool {
+			return yield(K(key), value)
+		})
+	}
+}
+
+func (t *trie[K, V]) Clear() {
+	t.root = &node[V]{}
+	t.size = 0
+}
+
+func (t *trie[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+func (t *trie[K, V]) Size() int {
+	return t.size
+}
+
+func (t *trie[K, V]) String() string {
+	str := "Trie({"
+	i := 0
+	walk(t.root, "", func(key string, value V) bool {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%v: %v", key, value)
+		i++
+		return true
+	})
+	return str + "})"
+}
+
+func commonPrefix(a, b string) int {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	return n
+}
@@ -0,0 +1,228 @@
+package trie
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func trieOf(keys ...string) Trie[string, int] {
+	t := New[string, int]()
+	for i, k := range keys {
+		t.Insert(k, i)
+	}
+	return t
+}
+
+func TestTrie_InsertDelete(t *testing.T) {
+	radix := &trie[string, int]{root: &node[int]{}}
+	var trie Trie[string, int] = radix
+	r := rand.New(rand.NewSource(1))
+	present := map[string]int{}
+	for i := range 5000 {
+		// Short keys over a small alphabet share many prefixes, which
+		// exercises splitting and merging edges.
+		var key strings.Builder
+		for range r.Intn(6) {
+			key.WriteByte("abc"[r.Intn(3)])
+		}
+		k := key.String()
+		expected, ok := present[k]
+		if r.Intn(3) == 0 {
+			v, removed := trie.Delete(k)
+			if removed != ok || (ok && *v != expected) {
+				t.Fatalf("Expected Delete(%q) to return %v, %v", k, expected, ok)
+			}
+			delete(present, k)
+		} else {
+			v, replaced := trie.Insert(k, i)
+			if replaced != ok || (ok && *v != expected) {
+				t.Fatalf("Expected Insert(%q) to return %v, %v", k, expected, ok)
+			}
+			present[k] = i
+		}
+	}
+
+	var keys []string
+	for k := range present {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	if trie.Size() != len(keys) {
+		t.Fatalf("Expected trie to have %v keys, but got %v", len(keys), trie.Size())
+	}
+	if values := trie.Keys().Values(); !slices.Equal(values, keys) {
+		t.Fatalf("Expected keys %v, but got %v", keys, values)
+	}
+	for k, expected := range present {
+		if v, found := trie.Get(k); !found || *v != expected {
+			t.Fatalf("Expected Get(%q) to return %v", k, expected)
+		}
+	}
+	checkCompressed(t, radix.root, true)
+}
+
+// checkCompressed fails if a node below the root holds no value and has fewer
+// than two children, which a radix tree merges or removes.
+func checkCompressed(t *testing.T, n *node[int], root bool) {
+	if !root && !n.leaf && len(n.children) < 2 {
+		t.Fatalf("Expected node %q without a value to have several children", n.prefix)
+	}
+	for _, c := range n.children {
+		checkCompressed(t, c, false)
+	}
+}
+
+func TestTrie_Get(t *testing.T) {
+	trie := trieOf("team", "tea", "ten", "")
+	scenarios := []struct {
+		key      string
+		expected int
+		found    bool
+	}{
+		{key: "team", expected: 0, found: true},
+		{key: "tea", expected: 1, found: true},
+		{key: "ten", expected: 2, found: true},
+		{key: "", expected: 3, found: true},
+		{key: "te"},
+		{key: "teams"},
+		{key: "x"},
+	}
+
+	for _, s := range scenarios {
+		v, found := trie.Get(s.key)
+		if found != s.found {
+			t.Fatalf("Expected Get(%q) to find %v, but got %v", s.key, s.found, found)
+		}
+		if found && *v != s.expected {
+			t.Fatalf("Expected Get(%q) to return %v, but got %v", s.key, s.expected, *v)
+		}
+	}
+}
+
+func TestTrie_Delete_MergesEdges(t *testing.T) {
+	trie := trieOf("team", "tea", "ten").(*trie[string, int])
+	trie.Delete("ten")
+	trie.Delete("tea")
+	if len(trie.root.children) != 1 || trie.root.children[0].prefix != "team" {
+		t.Fatalf("Expected the remaining edges to be merged into team, but got %v", trie.root.children[0].prefix)
+	}
+	if _, removed := trie.Delete("te"); removed {
+		t.Fatalf("Expected Delete of an inner prefix to fail")
+	}
+}
+
+func TestTrie_LongestPrefixMatch(t *testing.T) {
+	trie := trieOf("/", "/api", "/api/users", "/static")
+	scenarios := []struct {
+		key      string
+		expected string
+		found    bool
+	}{
+		{key: "/api/users/42", expected: "/api/users", found: true},
+		{key: "/api/user", expected: "/api", found: true},
+		{key: "/api", expected: "/api", found: true},
+		{key: "/index.html", expected: "/", found: true},
+		{key: "api"},
+		{key: ""},
+	}
+
+	for _, s := range scenarios {
+		key, v, found := trie.LongestPrefixMatch(s.key)
+		if found != s.found {
+			t.Fatalf("Expected LongestPrefixMatch(%q) to find %v, but got %v", s.key, s.found, found)
+		}
+		if found {
+			if expected, _ := trie.Get(s.expected); key != s.expected || *v != *expected {
+				t.Fatalf("Expected LongestPrefixMatch(%q) to return %q, but got %q", s.key, s.expected, key)
+			}
+		}
+	}
+}
+
+func TestTrie_KeysWithPrefix(t *testing.T) {
+	trie := trieOf("car", "cart", "carbon", "cat", "dog", "")
+	scenarios := []struct {
+		prefix   string
+		expected []string
+	}{
+		{prefix: "car", expected: []string{"car", "carbon", "cart"}},
+		{prefix: "ca", expected: []string{"car", "carbon", "cart", "cat"}},
+		{prefix: "carb", expected: []string{"carbon"}},
+		{prefix: "", expected: []string{"", "car", "carbon", "cart", "cat", "dog"}},
+		{prefix: "cars"},
+		{prefix: "x"},
+	}
+
+	for _, s := range scenarios {
+		if keys := trie.KeysWithPrefix(s.prefix); !slices.Equal(keys, s.expected) {
+			t.Fatalf("Expected KeysWithPrefix(%q) to be %v, but got %v", s.prefix, s.expected, keys)
+		}
+	}
+}
+
+func TestTrie_WalkPrefix_Stop(t *testing.T) {
+	trie := trieOf("a", "ab", "abc", "abd")
+	var visited []string
+	trie.WalkPrefix("ab", func(key string, _ int) bool {
+		visited = append(visited, key)
+		return len(visited) < 2
+	})
+	if !slices.Equal(visited, []string{"ab", "abc"}) {
+		t.Fatalf("Expected the walk to stop after [ab abc], but got %v", visited)
+	}
+}
+
+func TestTrie_ByteKeys(t *testing.T) {
+	trie := New[[]byte, string]()
+	trie.Insert([]byte{0x0a, 0x00}, "10.0")
+	trie.Insert([]byte{0x0a, 0x00, 0x01}, "10.0.1")
+	trie.Insert([]byte{0xc0, 0xa8}, "192.168")
+
+	key, v, found := trie.LongestPrefixMatch([]byte{0x0a, 0x00, 0x01, 0x07})
+	if !found || *v != "10.0.1" || !slices.Equal(key, []byte{0x0a, 0x00, 0x01}) {
+		t.Fatalf("Expected to match 10.0.1, but got %v", key)
+	}
+	if keys := trie.KeysWithPrefix([]byte{0x0a}); len(keys) != 2 {
+		t.Fatalf("Expected 2 keys under 10, but got %v", keys)
+	}
+}
+
+func TestTrie_Keys(t *testing.T) {
+	trie := trieOf("b", "a", "c")
+	keys := trie.Keys()
+	if !keys.Contains("a") || keys.Contains("d") {
+		t.Fatalf("Expected the key view to contain the keys of the trie")
+	}
+
+	trie.Insert("d", 3)
+	if keys.Size() != 4 || !keys.Contains("d") {
+		t.Fatalf("Expected the key view to reflect changes to the trie, but got %v", keys)
+	}
+
+	it := keys.Iterator()
+	for it.HasNext() {
+		if k, _ := it.Next(); *k != "c" {
+			it.Remove()
+		}
+	}
+	if trie.Size() != 1 || !trie.Contains("c") {
+		t.Fatalf("Expected removing through the key view to remove from the trie, but got %v", trie)
+	}
+
+	keys.Clear()
+	if !trie.IsEmpty() {
+		t.Fatalf("Expected Clear on the key view to clear the trie")
+	}
+}
+
+func TestTrie_String(t *testing.T) {
+	trie := trieOf("b", "a")
+	if s := trie.String(); s != "Trie({a: 1, b: 0})" {
+		t.Fatalf("Expected Trie({a: 1, b: 0}), but got %v", s)
+	}
+	if s := trie.Keys().String(); s != "TrieKeys([a, b])" {
+		t.Fatalf("Expected TrieKeys([a, b]), but got %v", s)
+	}
+}
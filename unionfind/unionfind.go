@@ -0,0 +1,223 @@
+// Package unionfind provides a disjoint-set forest, which partitions elements
+// into components and merges components in nearly constant amortized time.
+package unionfind
+
+import (
+	"fmt"
+
+	"github.com/elias8/go-gather/list"
+)
+
+// UnionFind partitions its elements into disjoint components. Every component
+// is identified by one of its elements, its representative, which changes
+// when the component is merged with another.
+//
+// Find uses path compression and Union uses union by rank, so any sequence of
+// m operations on n elements takes O(m α(n)) time, where α is the inverse
+// Ackermann function, which is below 5 for any practical n.
+type UnionFind[T comparable] interface {
+	// Add adds the element as a component of its own. Returns false if the
+	// element is already present.
+	Add(element T) bool
+
+	// Find returns the representative of the component of the element.
+	// Returns nil and false if the element is not present.
+	Find(element T) (*T, bool)
+
+	// Union merges the components of a and b, adding the elements that are
+	// not present yet. Returns false if they were already in the same
+	// component.
+	Union(a, b T) bool
+
+	// Connected returns true if a and b are present and in the same
+	// component.
+	Connected(a, b T) bool
+
+	// ComponentSize returns the number of elements in the component of the
+	// element, or 0 if the element is not present.
+	ComponentSize(element T) int
+
+	// Components returns the components as lists, ordered by the first
+	// element of each component to be added, and listing elements in the
+	// order they were added. The lists are copies; modifying them does not
+	// affect the structure.
+	Components() []list.List[T]
+
+	// Count returns the number of components.
+	Count() int
+
+	// Contains returns true if the element is present.
+	Contains(element T) bool
+
+	// Clear removes all elements.
+	Clear()
+
+	// IsEmpty returns true if there are no elements.
+	IsEmpty() bool
+
+	// Size returns the number of elements.
+	Size() int
+
+	// String returns string representation of the structure.
+	String() string
+}
+
+// unionFind stores its elements in the order they were added, identified by
+// their index. parent links every element towards the root of its tree, the
+// representative of its component, which is its own parent. rank bounds the
+// height of the tree of a root, and size counts the elements of its
+// component.
+type unionFind[T comparable] struct {
+	indices  map[T]int
+	elements []T
+	parent   []int
+	rank     []uint8
+	size     []int
+	count    int
+}
+
+// New returns an empty UnionFind.
+func New[T comparable]() UnionFind[T] {
+	return &unionFind[T]{indices: make(map[T]int)}
+}
+
+func (u *unionFind[T]) Add(element T) bool {
+	if _, ok := u.indices[element]; ok {
+		return false
+	}
+	u.add(element)
+	return true
+}
+
+// add adds an element that is not present and returns its index.
+func (u *unionFind[T]) add(element T) int {
+	i := len(u.elements)
+	u.indices[element] = i
+	u.elements = append(u.elements, element)
+	u.parent = append(u.parent, i)
+	u.rank = append(u.rank, 0)
+	u.size = append(u.size, 1)
+	u.count++
+	return i
+}
+
+// index returns the index of the element, adding it if it is not present.
+func (u *unionFind[T]) index(element T) int {
+	if i, ok := u.indices[element]; ok {
+		return i
+	}
+	return u.add(element)
+}
+
+// root returns the index of the root of the tree of the element at index i,
+// and makes every element on the way point directly to the root.
+func (u *unionFind[T]) root(i int) int {
+	r := i
+	for u.parent[r] != r {
+		r = u.parent[r]
+	}
+	for u.parent[i] != r {
+		u.parent[i], i = r, u.parent[i]
+	}
+	return r
+}
+
+func (u *unionFind[T]) Find(element T) (*T, bool) {
+	i, ok := u.indices[element]
+	if !ok {
+		return nil, false
+	}
+	representative := u.elements[u.root(i)]
+	return &representative, true
+}
+
+func (u *unionFind[T]) Union(a, b T) bool {
+	ra, rb := u.root(u.index(a)), u.root(u.index(b))
+	if ra == rb {
+		return false
+	}
+	if u.rank[ra] < u.rank[rb] {
+		ra, rb = rb, ra
+	}
+	u.parent[rb] = ra
+	u.size[ra] += u.size[rb]
+	if u.rank[ra] == u.rank[rb] {
+		u.rank[ra]++
+	}
+	u.count--
+	return true
+}
+
+func (u *unionFind[T]) Connected(a, b T) bool {
+	i, ok := u.indices[a]
+	j, found := u.indices[b]
+	return ok && found && u.root(i) == u.root(j)
+}
+
+func (u *unionFind[T]) ComponentSize(element T) int {
+	i, ok := u.indices[element]
+	if !ok {
+		return 0
+	}
+	return u.size[u.root(i)]
+}
+
+func (u *unionFind[T]) Components() []list.List[T] {
+	components := make([]list.List[T], 0, u.count)
+	byRoot := make(map[int]list.List[T], u.count)
+	for i, element := range u.elements {
+		r := u.root(i)
+		component, ok := byRoot[r]
+		if !ok {
+			component = list.NewComparableArrayList[T]()
+			byRoot[r] = component
+			components = append(components, component)
+		}
+		component.Add(element)
+	}
+	return components
+}
+
+func (u *unionFind[T]) Count() int {
+	return u.count
+}
+
+func (u *unionFind[T]) Contains(element T) bool {
+	_, ok := u.indices[element]
+	return ok
+}
+
+func (u *unionFind[T]) Clear() {
+	clear(u.indices)
+	u.elements = nil
+	u.parent = nil
+	u.rank = nil
+	u.size = nil
+	u.count = 0
+}
+
+func (u *unionFind[T]) IsEmpty() bool {
+	return len(u.elements) == 0
+}
+
+func (u *unionFind[T]) Size() int {
+	return len(u.elements)
+}
+
+func (u *unionFind[T]) String() string {
+	str := "UnionFind(["
+	for i, component := range u.Components() {
+		if i > 0 {
+			str += ", "
+		}
+		str += "["
+		for j, element := range component.Values() {
+			if j > 0 {
+				str += ", "
+			}
+			str += fmt.Sprintf("%v", element)
+		}
+		str += "]"
+	}
+	return str + "])"
+}
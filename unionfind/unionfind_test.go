@@ -0,0 +1,162 @@
+package unionfind
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestUnionFind_Union(t *testing.T) {
+	u := New[string]()
+	u.Add("a")
+	if u.Add("a") {
+		t.Fatalf("Expected Add of a present element to return false")
+	}
+	if !u.Union("a", "b") || !u.Union("c", "d") || !u.Union("b", "d") {
+		t.Fatalf("Expected Union of separate components to return true")
+	}
+	if u.Union("a", "c") {
+		t.Fatalf("Expected Union of connected elements to return false")
+	}
+	u.Add("e")
+
+	scenarios := []struct {
+		a, b      string
+		connected bool
+	}{
+		{a: "a", b: "d", connected: true},
+		{a: "c", b: "b", connected: true},
+		{a: "a", b: "a", connected: true},
+		{a: "a", b: "e"},
+		{a: "a", b: "z"},
+		{a: "z", b: "z"},
+	}
+	for _, s := range scenarios {
+		if connected := u.Connected(s.a, s.b); connected != s.connected {
+			t.Fatalf("Expected Connected(%v, %v) to be %v", s.a, s.b, s.connected)
+		}
+	}
+
+	if u.Size() != 5 || u.Count() != 2 {
+		t.Fatalf("Expected 5 elements in 2 components, but got %v and %v", u.Size(), u.Count())
+	}
+	if u.ComponentSize("c") != 4 || u.ComponentSize("e") != 1 || u.ComponentSize("z") != 0 {
+		t.Fatalf("Expected component sizes 4, 1 and 0")
+	}
+	ra, _ := u.Find("a")
+	rd, _ := u.Find("d")
+	if *ra != *rd {
+		t.Fatalf("Expected a and d to have the same representative, but got %v and %v", *ra, *rd)
+	}
+	if _, found := u.Find("z"); found {
+		t.Fatalf("Expected Find of an absent element to fail")
+	}
+}
+
+func TestUnionFind_Components(t *testing.T) {
+	u := New[int]()
+	for i := range 10 {
+		u.Add(i)
+	}
+	// Connect the elements with the same remainder modulo 3.
+	for i := 3; i < 10; i++ {
+		u.Union(i, i-3)
+	}
+
+	components := u.Components()
+	expected := [][]int{{0, 3, 6, 9}, {1, 4, 7}, {2, 5, 8}}
+	if len(components) != len(expected) {
+		t.Fatalf("Expected %v components, but got %v", len(expected), len(components))
+	}
+	for i, component := range components {
+		if values := component.Values(); !slices.Equal(values, expected[i]) {
+			t.Fatalf("Expected component %v to be %v, but got %v", i, expected[i], values)
+		}
+	}
+
+	components[0].Clear()
+	if u.ComponentSize(0) != 4 {
+		t.Fatalf("Expected modifying a component list to leave the structure unchanged")
+	}
+}
+
+func TestUnionFind_Random(t *testing.T) {
+	// Compare against components computed naively by relabelling.
+	const n = 500
+	u := New[int]()
+	label := make([]int, n)
+	for i := range label {
+		label[i] = i
+		u.Add(i)
+	}
+	r := rand.New(rand.NewSource(1))
+	for range 300 {
+		a, b := r.Intn(n), r.Intn(n)
+		merged := label[a] != label[b]
+		if u.Union(a, b) != merged {
+			t.Fatalf("Expected Union(%v, %v) to return %v", a, b, merged)
+		}
+		from, to := label[b], label[a]
+		for i := range label {
+			if label[i] == from {
+				label[i] = to
+			}
+		}
+	}
+
+	sizes := map[int]int{}
+	for _, l := range label {
+		sizes[l]++
+	}
+	if u.Count() != len(sizes) {
+		t.Fatalf("Expected %v components, but got %v", len(sizes), u.Count())
+	}
+	for i := range n {
+		if size := u.ComponentSize(i); size != sizes[label[i]] {
+			t.Fatalf("Expected the component of %v to have %v elements, but got %v", i, sizes[label[i]], size)
+		}
+		j := r.Intn(n)
+		if u.Connected(i, j) != (label[i] == label[j]) {
+			t.Fatalf("Expected Connected(%v, %v) to be %v", i, j, label[i] == label[j])
+		}
+	}
+}
+
+func TestUnionFind_PathCompression(t *testing.T) {
+	u := New[int]().(*unionFind[int])
+	// Union by rank keeps trees shallow, so build a deep tree by hand.
+	for i := range 5 {
+		u.Add(i)
+		if i > 0 {
+			u.parent[i] = i - 1
+		}
+	}
+	u.Find(4)
+	for i := range 5 {
+		if u.parent[i] != 0 {
+			t.Fatalf("Expected Find to make %v point to the root, but it points to %v", i, u.parent[i])
+		}
+	}
+}
+
+func TestUnionFind_Clear(t *testing.T) {
+	u := New[int]()
+	u.Union(1, 2)
+	u.Clear()
+	if !u.IsEmpty() || u.Count() != 0 || u.Contains(1) {
+		t.Fatalf("Expected structure to be empty after Clear, but got %v", u)
+	}
+	u.Union(1, 3)
+	if !u.Connected(1, 3) || u.Size() != 2 {
+		t.Fatalf("Expected structure to work after Clear, but got %v", u)
+	}
+}
+
+func TestUnionFind_String(t *testing.T) {
+	u := New[int]()
+	u.Union(1, 2)
+	u.Add(3)
+	if s := u.String(); s != "UnionFind([[1, 2], [3]])" {
+		t.Fatalf("Expected UnionFind([[1, 2], [3]]), but got %v", s)
+	}
+}